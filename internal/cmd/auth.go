@@ -4,11 +4,13 @@ import (
 	"context"
 	_ "embed"
 	"fmt"
+	"io"
 	"net"
 	"net/http"
 	"net/url"
 	"os"
 	"strconv"
+	"strings"
 	"text/template"
 	"time"
 
@@ -17,11 +19,17 @@ import (
 	"github.com/tursodatabase/turso-cli/internal"
 	"github.com/tursodatabase/turso-cli/internal/flags"
 	"github.com/tursodatabase/turso-cli/internal/settings"
+	"github.com/tursodatabase/turso-cli/internal/turso"
 )
 
 //go:embed login.html
 var LOGIN_HTML string
 
+var (
+	loginTokenFlag      string
+	loginTokenStdinFlag bool
+)
+
 var authCmd = &cobra.Command{
 	Use:               "auth",
 	Short:             "Authenticate with Turso",
@@ -82,7 +90,7 @@ var tokenCmd = &cobra.Command{
 			return fmt.Errorf("no user logged in. Run %s to log in and get a token", internal.Emph("turso auth login"))
 		}
 
-		fmt.Fprintln(os.Stderr, internal.Warn("Warning: this token is used to authenticate you to Turso platform API, not your databases."))
+		warnf("this token is used to authenticate you to Turso platform API, not your databases.")
 		fmt.Fprintf(os.Stderr, "%s %s %s\n", internal.Warn("Use"), internal.Emph("turso db tokens create"), internal.Warn("to create a database token."))
 
 		fmt.Println(token)
@@ -107,10 +115,26 @@ func init() {
 	authCmd.AddCommand(apiTokensCmd)
 	authCmd.AddCommand(whoAmICmd)
 	flags.AddHeadless(loginCmd)
+	loginCmd.Flags().StringVar(&loginTokenFlag, "token", "", "Log in with an existing platform API token instead of the browser flow.")
+	loginCmd.Flags().BoolVar(&loginTokenStdinFlag, "token-stdin", false, "Read the platform API token to log in with from stdin.")
+	loginCmd.MarkFlagsMutuallyExclusive("token", "token-stdin", "headless")
 	flags.AddHeadless(signupCmd)
 	flags.AddAll(logoutCmd, "Invalidate all sessions for the current user")
 }
 
+// normalizeToken trims the whitespace pasted tokens often carry and rejects
+// values that can't possibly be a token.
+func normalizeToken(token string) (string, error) {
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return "", fmt.Errorf("token is empty")
+	}
+	if strings.ContainsAny(token, " \t\r\n") {
+		return "", fmt.Errorf("token must not contain whitespace")
+	}
+	return token, nil
+}
+
 func isJwtTokenValid(token string) bool {
 	if len(token) == 0 {
 		return false
@@ -148,6 +172,10 @@ func auth(cmd *cobra.Command, path string) error {
 		return alreadySignedInError(settings)
 	}
 
+	if loginTokenFlag != "" || loginTokenStdinFlag {
+		return loginWithToken(settings)
+	}
+
 	if flags.Headless() {
 		return printHeadlessLoginInstructions(path)
 	}
@@ -172,7 +200,10 @@ func auth(cmd *cobra.Command, path string) error {
 	fmt.Println(url)
 	fmt.Println("Waiting for authentication...")
 
-	jwt := callbackServer.Result()
+	jwt, err := normalizeToken(callbackServer.Result())
+	if err != nil {
+		return suggestHeadless(cmd, fmt.Errorf("received an invalid token: %w", err))
+	}
 	username, err := validateToken(jwt)
 	if err != nil {
 		return suggestHeadless(cmd, err)
@@ -188,6 +219,36 @@ func auth(cmd *cobra.Command, path string) error {
 	return nil
 }
 
+// loginWithToken stores a token given with --token or --token-stdin, after
+// checking with the API that it is valid.
+func loginWithToken(config *settings.Settings) error {
+	token := loginTokenFlag
+	if loginTokenStdinFlag {
+		b, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return fmt.Errorf("could not read token from stdin: %w", err)
+		}
+		token = string(b)
+	}
+
+	jwt, err := normalizeToken(token)
+	if err != nil {
+		return fmt.Errorf("invalid token: %w", err)
+	}
+	username, err := validateToken(jwt)
+	if status := turso.StatusCode(err); status == http.StatusUnauthorized || status == http.StatusForbidden {
+		return fmt.Errorf("the token was rejected by the platform API, it is invalid or has expired. Create a new one with %s", internal.Emph("turso auth api-tokens mint"))
+	}
+	if err != nil {
+		return err
+	}
+
+	config.SetToken(jwt)
+	config.SetUsername(username)
+	fmt.Printf("✔  Success! Logged in as %s\n", username)
+	return nil
+}
+
 func validateToken(token string) (string, error) {
 	client, err := tursoClient(token)
 	if err != nil {
@@ -399,6 +460,9 @@ func whoAmI(cmd *cobra.Command, _ []string) error {
 }
 
 func checkEnvAuth(cmd *cobra.Command, args []string) error {
+	if err := rootPreRun(cmd, args); err != nil {
+		return err
+	}
 	cmd.SilenceUsage = true
 	token := os.Getenv(ENV_ACCESS_TOKEN)
 	if token != "" {
@@ -0,0 +1,42 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+)
+
+func Test_normalizeToken(t *testing.T) {
+	tests := []struct {
+		name    string
+		token   string
+		want    string
+		wantErr bool
+	}{
+		{name: "valid", token: "abc.def.ghi", want: "abc.def.ghi"},
+		{name: "trailing newline", token: "abc.def.ghi\n", want: "abc.def.ghi"},
+		{name: "surrounding whitespace", token: " \tabc.def.ghi\r\n ", want: "abc.def.ghi"},
+		{name: "empty", token: "", wantErr: true},
+		{name: "only whitespace", token: " \n\t", wantErr: true},
+		{name: "inner space", token: "abc def", wantErr: true},
+		{name: "inner newline", token: "abc\ndef", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := normalizeToken(tt.token)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("normalizeToken() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("normalizeToken() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAuthCommandsApplyGlobalFlags(t *testing.T) {
+	m := newMockTurso(t)
+	_, err := runCommand(t, m, "auth", "logout", "--max-concurrency", "0")
+	if err == nil || !strings.Contains(err.Error(), "max-concurrency") {
+		t.Fatalf("expected --max-concurrency to be validated before the env token check, got %v", err)
+	}
+}
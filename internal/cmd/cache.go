@@ -3,6 +3,7 @@ package cmd
 import (
 	"strings"
 
+	"github.com/tursodatabase/turso-cli/internal/flags"
 	"github.com/tursodatabase/turso-cli/internal/settings"
 	"github.com/tursodatabase/turso-cli/internal/turso"
 )
@@ -12,12 +13,18 @@ const (
 	DB_CACHE_TTL_SECONDS = 30 * 60
 )
 
+// databasesCacheKey keeps the databases of an organization selected with
+// --org or TURSO_ORG apart from the ones of the configured organization.
+func databasesCacheKey() string {
+	return orgKey(flags.Org(), DB_CACHE_KEY)
+}
+
 func setDatabasesCache(dbNames []turso.Database) {
-	settings.SetCache(DB_CACHE_KEY, DB_CACHE_TTL_SECONDS, dbNames)
+	settings.SetCache(databasesCacheKey(), DB_CACHE_TTL_SECONDS, dbNames)
 }
 
 func getDatabasesCache() []turso.Database {
-	data, err := settings.GetCache[[]turso.Database](DB_CACHE_KEY)
+	data, err := settings.GetCache[[]turso.Database](databasesCacheKey())
 	if err != nil {
 		return nil
 	}
@@ -25,7 +32,7 @@ func getDatabasesCache() []turso.Database {
 }
 
 func invalidateDatabasesCache() {
-	settings.InvalidateCache[[]turso.Database](DB_CACHE_KEY)
+	settings.InvalidateCache[[]turso.Database](databasesCacheKey())
 }
 
 const (
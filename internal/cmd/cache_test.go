@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/tursodatabase/turso-cli/internal/settings"
 	"github.com/tursodatabase/turso-cli/internal/turso"
 )
 
@@ -69,3 +70,14 @@ func Test_setLocationsCache(t *testing.T) {
 		t.Errorf("locationsCache() = %v, want %v", locationsCache(), locs)
 	}
 }
+
+func TestCompleteLocationIDsUsesCache(t *testing.T) {
+	t.Setenv("TURSO_API_BASEURL", "http://127.0.0.1:1")
+	t.Setenv(ENV_ACCESS_TOKEN, "")
+	setLocationsCache(map[string]string{"gru": "São Paulo, Brazil", "ams": "Amsterdam, Netherlands"})
+	defer settings.ClearCache()
+
+	if ids := completeLocationIDs(); !reflect.DeepEqual(ids, []string{"ams", "gru"}) {
+		t.Errorf("expected the cached locations, got %v", ids)
+	}
+}
@@ -1,9 +1,35 @@
 package cmd
 
-import "github.com/spf13/cobra"
+import (
+	"fmt"
 
-var canaryFlag bool
+	"github.com/spf13/cobra"
+)
+
+var (
+	canaryFlag bool
+	imageFlag  string
+)
 
 func addCanaryFlag(cmd *cobra.Command) {
-	cmd.Flags().BoolVar(&canaryFlag, "canary", false, "Use database canary build.")
+	cmd.Flags().BoolVar(&canaryFlag, "canary", false, "Use database canary build. Shortcut for --image canary.")
+	cmd.Flags().StringVar(&imageFlag, "image", "", "Database server image to use, for example 'latest', 'canary' or a specific build tag.")
+	_ = cmd.RegisterFlagCompletionFunc("image", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return []string{"latest", "canary"}, cobra.ShellCompDirectiveNoFileComp
+	})
+}
+
+// imageFromFlags returns the image selected with --image or --canary, or an
+// empty string when neither was given.
+func imageFromFlags() (string, error) {
+	if canaryFlag && imageFlag != "" && imageFlag != "canary" {
+		return "", fmt.Errorf("--canary conflicts with --image %s, use only one of them", imageFlag)
+	}
+	if imageFlag != "" {
+		return imageFlag, nil
+	}
+	if canaryFlag {
+		return "canary", nil
+	}
+	return "", nil
 }
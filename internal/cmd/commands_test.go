@@ -0,0 +1,506 @@
+package cmd
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const mockDatabases = `{"databases": [
+	{"dbId": "1", "Name": "db1", "Regions": ["ams"], "PrimaryRegion": "ams", "Hostname": "db1-org.turso.io", "Group": ""},
+	{"dbId": "2", "Name": "db2", "Regions": ["ams", "gru"], "PrimaryRegion": "ams", "Hostname": "db2-org.turso.io", "Group": ""}
+]}`
+
+func TestListCommand(t *testing.T) {
+	m := newMockTurso(t)
+	m.on("GET", "/v1/databases", http.StatusOK, mockDatabases)
+
+	out, err := runCommand(t, m, "db", "list")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{"db1", "libsql://db1-org.turso.io", "db2", "libsql://db2-org.turso.io"} {
+		if !strings.Contains(out, expected) {
+			t.Errorf("expected output to contain %q, got:\n%s", expected, out)
+		}
+	}
+
+	aliased, err := runCommand(t, m, "db", "ls")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if aliased != out {
+		t.Errorf("expected db ls to match db list, got:\n%s", aliased)
+	}
+
+	m.on("GET", "/v2/organizations", http.StatusOK, `{"organizations": [{"name": "me", "slug": "me", "type": "personal"}, {"name": "Team", "slug": "team", "type": "team"}]}`)
+	out, err = runCommand(t, m, "db", "list", "-o", "json", "--fields", "name,org", "--compact")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := `[{"name":"db1","org":"me"},{"name":"db2","org":"me"}]`; strings.TrimSpace(out) != expected {
+		t.Errorf("expected %s, got %s", expected, out)
+	}
+
+	m.on("GET", "/v1/databases/db2/instances", http.StatusOK, `{"instances": [
+		{"uuid": "b", "name": "gru-primary", "type": "primary", "region": "gru", "hostname": "gru-db2-org.turso.io"}
+	]}`)
+	out, err = runCommand(t, m, "db", "list", "--columns", "name,primary")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range strings.Split(strings.TrimSpace(out), "\n")[1:] {
+		if fields := strings.Fields(line); len(fields) != 2 || (fields[0] == "db1") != (fields[1] == "ams") {
+			t.Errorf("expected db1 to keep its reported primary and db2 to use its primary instance, got:\n%s", out)
+		}
+	}
+
+	m.on("GET", "/v1/databases", http.StatusOK, `{"databases": []}`)
+	out, err = runCommand(t, m, "db", "list")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out, "No databases yet") {
+		t.Errorf("unexpected output:\n%s", out)
+	}
+	out, err = runCommand(t, m, "db", "list", "-o", "json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.TrimSpace(out) != "[]" {
+		t.Errorf("expected an empty JSON array, got %s", out)
+	}
+
+	m.on("GET", "/v1/databases", http.StatusInternalServerError, `{"error": "database listing is unavailable"}`)
+	if _, err := runCommand(t, m, "db", "list"); err == nil || !strings.Contains(err.Error(), "database listing is unavailable") {
+		t.Fatalf("expected the API error, got %v", err)
+	}
+}
+
+func TestListJSONOrg(t *testing.T) {
+	m := newMockTurso(t)
+	m.on("GET", "/v1/databases", http.StatusOK, mockDatabases)
+
+	out, err := runCommand(t, m, "db", "list", "-o", "json", "--fields", "name", "--compact")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := `[{"name":"db1"},{"name":"db2"}]`; strings.TrimSpace(out) != expected {
+		t.Errorf("expected %s, got %s", expected, out)
+	}
+	if _, ok := m.received("GET", "/v2/organizations"); ok {
+		t.Error("expected the organization not to be looked up when org is not selected")
+	}
+
+	m.on("GET", "/v2/organizations", http.StatusInternalServerError, `{"error": "organizations are unavailable"}`)
+	out, err = runCommand(t, m, "db", "list", "-o", "json", "--fields", "name,org", "--compact")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := `[{"name":"db1","org":""},{"name":"db2","org":""}]`; strings.TrimSpace(out) != expected {
+		t.Errorf("expected the listing with an empty org, got %s", out)
+	}
+}
+
+func TestShowCommand(t *testing.T) {
+	m := newMockTurso(t)
+	m.on("GET", "/v1/databases", http.StatusOK, mockDatabases)
+	m.on("GET", "/v1/databases/db2/instances", http.StatusOK, `{"instances": [
+		{"uuid": "a", "name": "ams-primary", "type": "primary", "region": "ams", "hostname": "ams-db2-org.turso.io"},
+		{"uuid": "b", "name": "gru-replica", "type": "replica", "region": "gru", "hostname": "gru-db2-org.turso.io"}
+	]}`)
+	m.on("GET", "/v1/databases/db2/usage", http.StatusOK, `{"database": {"uuid": "2", "usage": {"rows_read": 10}}}`)
+
+	out, err := runCommand(t, m, "db", "show", "db2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{"db2", "libsql://db2-org.turso.io", "ams-primary", "gru-replica"} {
+		if !strings.Contains(out, expected) {
+			t.Errorf("expected output to contain %q, got:\n%s", expected, out)
+		}
+	}
+
+	out, err = runCommand(t, m, "db", "show", "db2", "--url", "--all-instances")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := "libsql://ams-db2-org.turso.io\nlibsql://gru-db2-org.turso.io\n"; out != expected {
+		t.Errorf("expected %q, got %q", expected, out)
+	}
+
+	out, err = runCommand(t, m, "db", "show", "db2", "--url", "--all-instances", "-o", "json", "--compact")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := `["libsql://ams-db2-org.turso.io","libsql://gru-db2-org.turso.io"]`; strings.TrimSpace(out) != expected {
+		t.Errorf("expected %s, got %s", expected, out)
+	}
+
+	if _, err := runCommand(t, m, "db", "show", "db3"); err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Fatalf("expected a not found error, got %v", err)
+	}
+}
+
+func TestCreateCommand(t *testing.T) {
+	m := newMockTurso(t)
+	m.on("GET", "/v1/databases", http.StatusOK, mockDatabases)
+	m.on("POST", "/v1/databases", http.StatusOK, `{"database": {"dbId": "3", "Name": "db3", "Regions": ["ams"], "PrimaryRegion": "ams", "Hostname": "db3-org.turso.io", "Group": "default"}}`)
+
+	out, err := runCommand(t, m, "db", "create", "db3", "--location", "ams")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out, "Created database db3 at group default") {
+		t.Errorf("unexpected output:\n%s", out)
+	}
+	body, _ := m.received("POST", "/v1/databases")
+	if !strings.Contains(body, `"db3"`) || !strings.Contains(body, `"default"`) {
+		t.Errorf("unexpected request body: %s", body)
+	}
+
+	m.on("POST", "/v1/databases/db3/auth/tokens", http.StatusOK, `{"jwt": "it's-a-token"}`)
+	out, err = runCommand(t, m, "db", "create", "db3", "--location", "ams", "--output-env")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := "export TURSO_DATABASE_URL='libsql://db3-org.turso.io'\nexport TURSO_AUTH_TOKEN='it'\\''s-a-token'\n"; out != expected {
+		t.Errorf("expected %q, got %q", expected, out)
+	}
+
+	if _, err := runCommand(t, m, "db", "create", "db3", "--location", "xyz"); err == nil || !strings.Contains(err.Error(), "location 'xyz' is not valid") {
+		t.Fatalf("expected an invalid location error, got %v", err)
+	}
+
+	m.on("POST", "/v1/databases", http.StatusUnprocessableEntity, `{"error": "name taken"}`)
+	if _, err := runCommand(t, m, "db", "create", "db3", "--location", "ams"); err == nil || !strings.Contains(err.Error(), "database name 'db3' is not available") {
+		t.Fatalf("expected a name not available error, got %v", err)
+	}
+}
+
+func TestCreateIfNotExists(t *testing.T) {
+	m := newMockTurso(t)
+	m.on("GET", "/v1/databases", http.StatusOK, mockDatabases)
+
+	out, err := runCommand(t, m, "db", "create", "db2", "--location", "gru", "--if-not-exists")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out != "" {
+		t.Errorf("expected no output, got %q", out)
+	}
+	if _, ok := m.received("POST", "/v1/databases"); ok {
+		t.Error("expected no database to be created")
+	}
+
+	if _, err := runCommand(t, m, "db", "create", "--if-not-exists"); err == nil {
+		t.Error("expected --if-not-exists without a name to fail")
+	}
+}
+
+func TestDestroyCommand(t *testing.T) {
+	m := newMockTurso(t)
+	m.on("GET", "/v1/databases", http.StatusOK, mockDatabases)
+	m.on("DELETE", "/v1/databases/db1", http.StatusOK, `{}`)
+
+	out, err := runCommand(t, m, "db", "destroy", "db1", "--yes")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out, "Destroyed database db1") {
+		t.Errorf("unexpected output:\n%s", out)
+	}
+	if _, ok := m.received("DELETE", "/v1/databases/db1"); !ok {
+		t.Error("expected the database to be deleted")
+	}
+
+	if _, err := runCommand(t, m, "db", "destroy", "db1", "--location", "gru"); err == nil || !strings.Contains(err.Error(), "has no instances in location gru") {
+		t.Fatalf("expected a missing location error, got %v", err)
+	}
+
+	m.on("DELETE", "/v1/databases/db1", http.StatusNotFound, `{"error": "not found"}`)
+	if _, err := runCommand(t, m, "db", "destroy", "db1", "--yes"); err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Fatalf("expected a not found error, got %v", err)
+	}
+}
+
+func TestDestroyListsInstances(t *testing.T) {
+	m := newMockTurso(t)
+	m.on("GET", "/v1/databases", http.StatusOK, mockDatabases)
+	m.on("GET", "/v1/databases/db2/instances", http.StatusOK, `{"instances": [
+		{"uuid": "b", "name": "gru-replica", "type": "replica", "region": "gru", "hostname": "gru-db2-org.turso.io"},
+		{"uuid": "a", "name": "ams-primary", "type": "primary", "region": "ams", "hostname": "ams-db2-org.turso.io"}
+	]}`)
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _ = w.WriteString("n\n")
+	w.Close()
+	stdin := os.Stdin
+	os.Stdin = r
+	defer func() { os.Stdin = stdin }()
+
+	out, err := runCommand(t, m, "db", "destroy", "db2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	primary, replica := strings.Index(out, "ams-primary"), strings.Index(out, "gru-replica")
+	if !strings.Contains(out, "with its 2 instances") || primary == -1 || replica < primary {
+		t.Errorf("expected the instances to be listed, primary first, got:\n%s", out)
+	}
+	if _, ok := m.received("DELETE", "/v1/databases/db2"); ok {
+		t.Error("expected the database not to be deleted")
+	}
+}
+
+func TestReplicateCommand(t *testing.T) {
+	m := newMockTurso(t)
+	m.on("GET", "/v1/databases", http.StatusOK, mockDatabases)
+	m.on("POST", "/v1/databases/db1/instances", http.StatusOK, `{"instance": {"uuid": "c", "name": "gru-replica", "type": "replica", "region": "gru", "hostname": "gru-db1-org.turso.io"}}`)
+
+	out, err := runCommand(t, m, "db", "replicate", "db1", "gru")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out, "Replicated database db1") || !strings.Contains(out, "--instance-url gru-replica") {
+		t.Errorf("unexpected output:\n%s", out)
+	}
+	body, _ := m.received("POST", "/v1/databases/db1/instances")
+	if !strings.Contains(body, `"gru"`) {
+		t.Errorf("unexpected request body: %s", body)
+	}
+
+	m.on("POST", "/v1/databases/db1/instances", http.StatusBadRequest, `{"error": "replica limit reached"}`)
+	if _, err := runCommand(t, m, "db", "replicate", "db1", "gru"); err == nil || !strings.Contains(err.Error(), "replica limit reached") {
+		t.Fatalf("expected the API error, got %v", err)
+	}
+
+	m.on("POST", "/v1/databases/db1/instances", http.StatusOK, `{"error": "capacity"}`)
+	if _, err := runCommand(t, m, "db", "replicate", "db1", "gru"); err == nil || !strings.Contains(err.Error(), "capacity") {
+		t.Fatalf("expected the error in the response body, got %v", err)
+	}
+}
+
+func TestReplicateWaitTimeout(t *testing.T) {
+	m := newMockTurso(t)
+	m.on("GET", "/v1/databases", http.StatusOK, mockDatabases)
+	m.on("POST", "/v1/databases/db1/auth/tokens", http.StatusOK, `{"jwt": "token"}`)
+	m.on("POST", "/v1/databases/db1/instances", http.StatusOK, `{"instance": {"uuid": "c", "name": "gru-replica", "type": "replica", "region": "gru", "hostname": "gru-db1-org.turso.io"}}`)
+	// Another replica in the same location must not be mistaken for the new one.
+	m.on("GET", "/v1/databases/db1/instances", http.StatusOK, `{"instances": [
+		{"uuid": "a", "name": "ams-primary", "type": "primary", "region": "ams", "hostname": "ams-db1-org.turso.io"},
+		{"uuid": "b", "name": "gru-old", "type": "replica", "region": "gru", "hostname": "gru-old-db1-org.turso.io"}
+	]}`)
+
+	_, err := runCommand(t, m, "db", "replicate", "db1", "gru", "--wait", "--timeout", "100ms")
+	if err == nil || !strings.Contains(err.Error(), "replica gru-replica of db1 at gru was not ready") || !strings.Contains(err.Error(), "the replica is not listed yet") {
+		t.Fatalf("expected the new replica to time out, got %v", err)
+	}
+	if code := exitCode(replicateCmd, err); code != exitCodeTimeout {
+		t.Errorf("expected exit code %d, got %d", exitCodeTimeout, code)
+	}
+}
+
+func TestWaitCommandLocation(t *testing.T) {
+	m := newMockTurso(t)
+	m.on("GET", "/v1/databases", http.StatusOK, mockDatabases)
+	m.on("GET", "/v1/databases/db1/instances", http.StatusOK, `{"instances": [
+		{"uuid": "a", "name": "ams-primary", "type": "primary", "region": "ams", "hostname": "ams-db1-org.turso.io"}
+	]}`)
+
+	if _, err := runCommand(t, m, "db", "wait", "db1", "--location", "gru"); err == nil || !strings.Contains(err.Error(), "has no instances in location gru") {
+		t.Fatalf("expected a missing location error, got %v", err)
+	}
+}
+
+func TestShowHistoryCommand(t *testing.T) {
+	m := newMockTurso(t)
+	m.on("GET", "/v1/databases", http.StatusOK, mockDatabases)
+	m.on("GET", "/v1/audit-logs", http.StatusOK, `{"audit_logs": [
+		{"code": "instance-create", "message": "replica created", "author": "me", "created_at": "2024-01-02T00:00:00Z", "data": {"database": {"name": "db2"}, "location": "gru"}},
+		{"code": "db-create", "message": "database created", "author": "me", "created_at": "2024-01-01T00:00:00Z", "data": {"name": "db1"}}
+	]}`)
+
+	out, err := runCommand(t, m, "db", "show", "db2", "--history")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out, "instance-create") || strings.Contains(out, "db-create") {
+		t.Errorf("unexpected output:\n%s", out)
+	}
+
+	m.on("GET", "/v1/audit-logs", http.StatusNotFound, `{"error": "not found"}`)
+	out, err = runCommand(t, m, "db", "show", "db2", "--history")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out, "No history available") {
+		t.Errorf("unexpected output:\n%s", out)
+	}
+}
+
+func TestApplyCommand(t *testing.T) {
+	m := newMockTurso(t)
+	m.on("GET", "/v1/databases", http.StatusOK, mockDatabases)
+	m.on("POST", "/v1/databases", http.StatusOK, `{"database": {"dbId": "3", "Name": "db3", "Regions": ["ams"], "PrimaryRegion": "ams", "Hostname": "db3-org.turso.io", "Group": "default"}}`)
+	m.on("POST", "/v1/databases/db1/instances", http.StatusOK, `{"instance": {"uuid": "c", "name": "gru-replica", "type": "replica", "region": "gru", "hostname": "gru-db1-org.turso.io"}}`)
+
+	manifest := filepath.Join(t.TempDir(), "databases.yaml")
+	err := os.WriteFile(manifest, []byte("databases:\n  - name: db1\n    location: ams\n    replicas: [gru]\n  - name: db2\n    location: ams\n    replicas: [gru]\n  - name: db3\n    location: ams\n"), 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	out, err := runCommand(t, m, "db", "apply", manifest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{"replicated to gru", "up to date", "created in ams"} {
+		if !strings.Contains(out, expected) {
+			t.Errorf("expected output to contain %q, got:\n%s", expected, out)
+		}
+	}
+	if _, ok := m.received("DELETE", "/v1/databases/db2"); ok {
+		t.Error("expected databases to be kept without --prune")
+	}
+
+	err = os.WriteFile(manifest, []byte("databases:\n  - name: db1\n    location: xyz\n"), 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := runCommand(t, m, "db", "apply", manifest); err == nil || !strings.Contains(err.Error(), "line 2: location 'xyz' is not valid") {
+		t.Fatalf("expected an invalid location error, got %v", err)
+	}
+}
+
+func TestDefaultDatabase(t *testing.T) {
+	m := newMockTurso(t)
+	m.on("GET", "/v1/databases", http.StatusOK, mockDatabases)
+
+	if _, err := runCommand(t, m, "db", "config", "set-default", "nope"); err == nil {
+		t.Error("expected setting a database that doesn't exist as default to fail")
+	}
+	if _, err := runCommand(t, m, "db", "config", "set-default", "db2"); err != nil {
+		t.Fatal(err)
+	}
+	out, err := runCommand(t, m, "db", "show", "--url")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := "libsql://db2-org.turso.io\n"; out != expected {
+		t.Errorf("expected %q, got %q", expected, out)
+	}
+	out, err = runCommand(t, m, "db", "show", "db1", "--url")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := "libsql://db1-org.turso.io\n"; out != expected {
+		t.Errorf("expected a name argument to override the default, got %q", out)
+	}
+
+	if _, err := runCommand(t, m, "db", "config", "unset-default"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := runCommand(t, m, "db", "show", "--url"); err == nil || !strings.Contains(err.Error(), "set-default") {
+		t.Errorf("expected an error pointing to set-default, got %v", err)
+	}
+}
+
+func TestDatabaseDescription(t *testing.T) {
+	m := newMockTurso(t)
+	m.on("GET", "/v1/databases", http.StatusOK, mockDatabases)
+	m.on("GET", "/v2/organizations", http.StatusOK, `{"organizations": [{"name": "me", "slug": "me", "type": "personal"}]}`)
+
+	if _, err := runCommand(t, m, "db", "describe", "db1", "prod users db"); err != nil {
+		t.Fatal(err)
+	}
+	out, err := runCommand(t, m, "db", "describe", "db1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out != "prod users db\n" {
+		t.Errorf("expected the description, got %q", out)
+	}
+	out, err = runCommand(t, m, "db", "list", "-o", "json", "--fields", "name,description", "--compact")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := `[{"description":"prod users db","name":"db1"},{"description":"","name":"db2"}]`; strings.TrimSpace(out) != expected {
+		t.Errorf("expected %s, got %s", expected, out)
+	}
+
+	if _, err := runCommand(t, m, "db", "describe", "db1", ""); err != nil {
+		t.Fatal(err)
+	}
+	out, err = runCommand(t, m, "db", "describe", "db1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out, "has no description") {
+		t.Errorf("expected the description to be removed, got %q", out)
+	}
+}
+
+func TestCreateOutputEnvWithoutGroups(t *testing.T) {
+	m := newMockTurso(t)
+	m.on("GET", "/v1/groups", http.StatusOK, `{"groups": []}`)
+	m.on("POST", "/v1/groups", http.StatusOK, `{}`)
+	m.on("GET", "/v1/groups/default/locations/ams/wait", http.StatusOK, `{}`)
+	m.on("GET", "/v1/databases", http.StatusOK, `{"databases": []}`)
+	m.on("POST", "/v1/databases", http.StatusOK, `{"database": {"dbId": "3", "Name": "db3", "Regions": ["ams"], "PrimaryRegion": "ams", "Hostname": "db3-org.turso.io", "Group": "default"}}`)
+	m.on("POST", "/v1/databases/db3/auth/tokens", http.StatusOK, `{"jwt": "token"}`)
+
+	out, err := runCommand(t, m, "db", "create", "db3", "--location", "ams", "--output-env")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := m.received("POST", "/v1/groups"); !ok {
+		t.Error("expected the default group to be created")
+	}
+	if expected := "export TURSO_DATABASE_URL='libsql://db3-org.turso.io'\nexport TURSO_AUTH_TOKEN='token'\n"; out != expected {
+		t.Errorf("expected only export lines on stdout, got %q", out)
+	}
+}
+
+func TestCreatePrintsTopology(t *testing.T) {
+	m := newMockTurso(t)
+	m.on("GET", "/v1/databases", http.StatusOK, mockDatabases)
+	m.on("POST", "/v1/databases", http.StatusOK, `{"database": {"dbId": "2", "Name": "db2", "Regions": ["ams"], "PrimaryRegion": "ams", "Hostname": "db2-org.turso.io", "Group": "default"}}`)
+
+	out, err := runCommand(t, m, "db", "create", "db2", "--location", "ams")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out, "Database db2 now spans 2 locations: ams, gru.") {
+		t.Errorf("expected the locations of db2 in the output:\n%s", out)
+	}
+
+	out, err = runCommand(t, m, "db", "create", "db2", "--location", "ams", "--quiet")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(out, "now spans") {
+		t.Errorf("expected no topology with --quiet:\n%s", out)
+	}
+}
+
+func TestCompletionCommand(t *testing.T) {
+	m := newMockTurso(t)
+	// cobra adds its completion command when the CLI is executed.
+	if _, err := runCommand(t, m, "completion", "--help"); err != nil {
+		t.Fatal(err)
+	}
+	completion, _, err := rootCmd.Find([]string{"completion"})
+	if err != nil || completion.Name() != "completion" || completion.Hidden {
+		t.Fatalf("expected a listed completion command, got %v", err)
+	}
+	for _, shell := range []string{"bash", "zsh", "fish", "powershell"} {
+		if cmd, _, err := rootCmd.Find([]string{"completion", shell}); err != nil || cmd.Name() != shell {
+			t.Errorf("expected a completion command for %s, got %v", shell, err)
+		}
+	}
+}
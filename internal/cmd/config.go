@@ -73,7 +73,10 @@ var configSetTokenCmd = &cobra.Command{
 			return fmt.Errorf("failed to read settings: %w", err)
 		}
 
-		token := args[0]
+		token, err := normalizeToken(args[0])
+		if err != nil {
+			return fmt.Errorf("invalid token: %w", err)
+		}
 		if !isJwtTokenValid(token) {
 			return fmt.Errorf("invalid token")
 		}
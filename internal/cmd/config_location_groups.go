@@ -0,0 +1,128 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/tursodatabase/turso-cli/internal"
+	"github.com/tursodatabase/turso-cli/internal/settings"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+)
+
+func init() {
+	configCmd.AddCommand(configLocationGroupsCmd)
+	configLocationGroupsCmd.AddCommand(configLocationGroupsSetCmd)
+	configLocationGroupsCmd.AddCommand(configLocationGroupsListCmd)
+	configLocationGroupsCmd.AddCommand(configLocationGroupsRemoveCmd)
+}
+
+var configLocationGroupsCmd = &cobra.Command{
+	Use:   "location-groups",
+	Short: "Manage named sets of locations, used with --location-group",
+}
+
+var configLocationGroupsSetCmd = &cobra.Command{
+	Use:               "set <name> <location-id>[,<location-id>...]",
+	Short:             "Create or replace a location group",
+	Example:           "  turso config location-groups set us iad,sjc\n  turso config location-groups set eu fra,lhr",
+	Args:              cobra.ExactArgs(2),
+	ValidArgsFunction: cobra.NoFileCompletions,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		name := strings.ToLower(args[0])
+		if strings.Contains(name, ",") {
+			return fmt.Errorf("location group names can't contain commas")
+		}
+		cmd.SilenceUsage = true
+
+		client, err := authedTursoClient()
+		if err != nil {
+			return err
+		}
+
+		var members []string
+		for _, location := range strings.Split(args[1], ",") {
+			location = strings.TrimSpace(location)
+			if location == "" || slices.Contains(members, location) {
+				continue
+			}
+			if !isValidLocation(client, location) {
+				return invalidLocationError(client, location)
+			}
+			members = append(members, location)
+		}
+		if len(members) == 0 {
+			return fmt.Errorf("a location group needs at least one location")
+		}
+
+		config, err := settings.ReadSettings()
+		if err != nil {
+			return fmt.Errorf("failed to read settings: %w", err)
+		}
+		config.SetLocationGroup(name, members)
+		if err := settings.TryToPersistChanges(); err != nil {
+			return err
+		}
+		fmt.Printf("Location group %s set to %s.\n", internal.Emph(name), strings.Join(members, ", "))
+		return nil
+	},
+}
+
+var configLocationGroupsListCmd = &cobra.Command{
+	Use:               "list",
+	Short:             "List location groups",
+	Args:              cobra.NoArgs,
+	ValidArgsFunction: cobra.NoFileCompletions,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		config, err := settings.ReadSettings()
+		if err != nil {
+			return fmt.Errorf("failed to read settings: %w", err)
+		}
+		groups := config.LocationGroups()
+		if len(groups) == 0 {
+			fmt.Printf("No location groups. Create one with %s\n", internal.Emph("turso config location-groups set <name> <location-ids>"))
+			return nil
+		}
+
+		names := maps.Keys(groups)
+		sort.Strings(names)
+		data := make([][]string, 0, len(names))
+		for _, name := range names {
+			data = append(data, []string{name, strings.Join(groups[name], ", ")})
+		}
+		printTable([]string{"Name", "Locations"}, data)
+		return nil
+	},
+}
+
+var configLocationGroupsRemoveCmd = &cobra.Command{
+	Use:               "rm <name>",
+	Short:             "Remove a location group",
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: locationGroupArg,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		config, err := settings.ReadSettings()
+		if err != nil {
+			return fmt.Errorf("failed to read settings: %w", err)
+		}
+		name := strings.ToLower(args[0])
+		if !config.DeleteLocationGroup(name) {
+			return fmt.Errorf("location group %s does not exist", name)
+		}
+		if err := settings.TryToPersistChanges(); err != nil {
+			return err
+		}
+		fmt.Printf("Location group %s removed.\n", internal.Emph(name))
+		return nil
+	},
+}
+
+func locationGroupArg(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	config, err := settings.ReadSettings()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return maps.Keys(config.LocationGroups()), cobra.ShellCompDirectiveNoFileComp
+}
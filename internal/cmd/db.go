@@ -4,12 +4,17 @@ import (
 	"fmt"
 	"math"
 	"os"
+	"sort"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/tursodatabase/turso-cli/internal"
+	"github.com/tursodatabase/turso-cli/internal/flags"
 	"github.com/tursodatabase/turso-cli/internal/settings"
 	"github.com/tursodatabase/turso-cli/internal/turso"
+	"golang.org/x/exp/maps"
 )
 
 var (
@@ -17,6 +22,10 @@ var (
 	showHttpUrlFlag      bool
 	showInstanceUrlsFlag bool
 	showInstanceUrlFlag  string
+	showAllInstancesFlag bool
+	showHistoryFlag      bool
+	showWatchFlag        bool
+	showIntervalFlag     time.Duration
 )
 
 func getInstanceNames(client *turso.Client, dbName string) []string {
@@ -39,6 +48,59 @@ func extractDatabaseNames(databases []turso.Database) []string {
 	return names
 }
 
+type databaseInfo struct {
+	ID              string   `json:"id"`
+	Name            string   `json:"name"`
+	URL             string   `json:"url"`
+	Hostname        string   `json:"hostname"`
+	Locations       []string `json:"locations"`
+	PrimaryLocation string   `json:"primary_location"`
+	Replicas        int      `json:"replicas"`
+	Group           string   `json:"group,omitempty"`
+	Org             string   `json:"org,omitempty"`
+	Version         string   `json:"version,omitempty"`
+	Description     string   `json:"description,omitempty"`
+	Sleeping        bool     `json:"sleeping"`
+
+	Instances []instanceInfo `json:"instances,omitempty"`
+}
+
+type instanceInfo struct {
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Location string `json:"location"`
+	URL      string `json:"url"`
+}
+
+func newInstanceInfos(db turso.Database, instances []turso.Instance) []instanceInfo {
+	infos := make([]instanceInfo, 0, len(instances))
+	for _, instance := range instances {
+		infos = append(infos, instanceInfo{
+			Name:     instance.Name,
+			Type:     instance.Type,
+			Location: instance.Region,
+			URL:      getInstanceUrl(&db, &instance),
+		})
+	}
+	return infos
+}
+
+func newDatabaseInfo(db turso.Database) databaseInfo {
+	return databaseInfo{
+		ID:              db.ID,
+		Name:            db.Name,
+		URL:             getDatabaseUrl(&db),
+		Hostname:        db.Hostname,
+		Locations:       db.Regions,
+		PrimaryLocation: db.PrimaryRegion,
+		Replicas:        len(db.Regions),
+		Group:           db.Group,
+		Version:         db.Version,
+		Description:     databaseDescription(db.ID),
+		Sleeping:        db.Sleeping,
+	}
+}
+
 func getDatabase(client *turso.Client, name string, fresh ...bool) (turso.Database, error) {
 	databases, err := getDatabases(client, fresh...)
 	if err != nil {
@@ -59,6 +121,7 @@ func getDatabases(client *turso.Client, fresh ...bool) ([]turso.Database, error)
 	if cachedNames := getDatabasesCache(); !skipCache && cachedNames != nil {
 		return cachedNames, nil
 	}
+	defer timePhase("list")()
 	databases, err := client.Databases.List()
 	if err != nil {
 		return nil, err
@@ -136,6 +199,10 @@ func envAccessToken() (string, error) {
 	if token == "" {
 		return "", nil
 	}
+	token, err := normalizeToken(token)
+	if err != nil {
+		return "", fmt.Errorf("token in %s env var is invalid: %w", ENV_ACCESS_TOKEN, err)
+	}
 	if !isJwtTokenValid(token) {
 		return "", fmt.Errorf("token in %s env var is invalid. Update the env var with a valid value, or unset it to use a token from the configuration file", ENV_ACCESS_TOKEN)
 	}
@@ -153,6 +220,7 @@ func locations(client *turso.Client) (map[string]string, error) {
 }
 
 func latencies(client *turso.Client) (map[string]int, error) {
+	defer timePhase("probe")()
 	settings, _ := settings.ReadSettings()
 	locations, err := readLocations(settings, client)
 	if err != nil {
@@ -162,10 +230,13 @@ func latencies(client *turso.Client) (map[string]int, error) {
 	var wg sync.WaitGroup
 	latencies := make(map[string]int)
 	c := make(chan latMap, len(locations))
+	sem := make(chan struct{}, flags.MaxConcurrency())
 	for id := range locations {
 		wg.Add(1)
 		go func(id string) {
 			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
 			measure := math.MaxInt
 			// XXX: Running this in different goroutines makes all latencies dogslow.
 			// Not sure if this is contention at the client or API level
@@ -207,7 +278,8 @@ func closestLocation(client *turso.Client) (string, error) {
 		return closest, nil
 	}
 
-	closest, err := client.Locations.Closest()
+	defer timePhase("probe")()
+	closest, err := client.Locations.Closest(flags.ProbeTimeout())
 	if err != nil {
 		// We fallback to ams if we are unable to probe the closest location.
 		return "ams", err
@@ -226,6 +298,13 @@ func isValidLocation(client *turso.Client, location string) bool {
 	return ok
 }
 
+func invalidLocationError(client *turso.Client, location string) error {
+	locations, _ := locations(client)
+	ids := maps.Keys(locations)
+	sort.Strings(ids)
+	return fmt.Errorf("location '%s' is not valid. Valid location IDs are: %s", location, strings.Join(ids, ", "))
+}
+
 func formatLocation(client *turso.Client, id string) string {
 	locations, _ := locations(client)
 	if desc, ok := locations[id]; ok {
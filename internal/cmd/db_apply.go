@@ -0,0 +1,328 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/tursodatabase/turso-cli/internal"
+	"github.com/tursodatabase/turso-cli/internal/prompt"
+	"github.com/tursodatabase/turso-cli/internal/turso"
+	"golang.org/x/exp/slices"
+	"gopkg.in/yaml.v3"
+)
+
+var pruneFlag bool
+
+func init() {
+	dbCmd.AddCommand(dbApplyCmd)
+	addGroupFlag(dbApplyCmd)
+	addYesFlag(dbApplyCmd, "Confirms the destruction of the databases removed with --prune.")
+	dbApplyCmd.Flags().BoolVar(&pruneFlag, "prune", false, "Destroy the databases that are not listed in the manifest.")
+}
+
+const manifestExample = `databases:
+  - name: users
+    location: ams
+    replicas: [gru, iad]
+  - name: orders
+    location: iad
+    group: default`
+
+var dbApplyCmd = &cobra.Command{
+	Use:   "apply <manifest-file>",
+	Short: "Create databases and replicas from a YAML manifest.",
+	Long: "Create the databases listed in a YAML manifest that don't exist yet, and add the replicas they are missing.\n" +
+		"Databases that are not in the manifest are left alone, unless --prune is used.\n\n" +
+		"A manifest looks like:\n\n" + manifestExample,
+	Example: "  turso db apply databases.yaml\n  turso db apply databases.yaml --prune --yes",
+	Args:    cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		data, err := os.ReadFile(args[0])
+		if err != nil {
+			return fmt.Errorf("could not read manifest: %w", err)
+		}
+		manifest, err := parseManifest(data)
+		if err != nil {
+			return fmt.Errorf("%s: %w", args[0], err)
+		}
+		cmd.SilenceUsage = true
+
+		client, err := authedTursoClient()
+		if err != nil {
+			return err
+		}
+		if err := validateManifest(client, manifest); err != nil {
+			return fmt.Errorf("%s: %w", args[0], err)
+		}
+		databases, err := getDatabasesMap(client, true)
+		if err != nil {
+			return err
+		}
+
+		var prune []string
+		if pruneFlag {
+			prune = unlistedDatabases(manifest, databases)
+			if len(prune) > 0 && !yesFlag {
+				fmt.Printf("Databases %s are not in the manifest and will be destroyed, with all their data.\n", internal.Emph(strings.Join(prune, ", ")))
+				ok, err := promptConfirmation("Are you sure you want to do this?")
+				if err != nil {
+					return fmt.Errorf("could not get prompt confirmed by user: %w", err)
+				}
+				if !ok {
+					fmt.Println("Apply skipped by the user.")
+					return nil
+				}
+			}
+		}
+
+		failed := 0
+		results := make([][]string, 0, len(manifest.Databases)+len(prune))
+		for _, database := range manifest.Databases {
+			existing, ok := databases[database.Name]
+			var current *turso.Database
+			if ok {
+				current = &existing
+			}
+			action, err := applyDatabase(client, database, current)
+			if err != nil {
+				action = err.Error()
+				failed++
+			}
+			results = append(results, []string{database.Name, action})
+		}
+		if len(prune) > 0 {
+			action := "destroyed"
+			if err := destroyDatabases(client, prune); err != nil {
+				action = err.Error()
+				failed += len(prune)
+			}
+			for _, name := range prune {
+				results = append(results, []string{name, action})
+			}
+		}
+		invalidateDatabasesCache()
+
+		printTable([]string{"Database", "Result"}, results)
+		if failed > 0 {
+			return fmt.Errorf("failed to apply %d of %d databases", failed, len(results))
+		}
+		return nil
+	},
+}
+
+type manifestDatabase struct {
+	Name     string   `yaml:"name"`
+	Location string   `yaml:"location"`
+	Replicas []string `yaml:"replicas"`
+	Group    string   `yaml:"group"`
+	line     int
+}
+
+type dbManifest struct {
+	Databases []manifestDatabase
+}
+
+// parseManifest reads and checks the structure of a manifest, reporting
+// problems with the line they are in.
+func parseManifest(data []byte) (*dbManifest, error) {
+	var root yaml.Node
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		return nil, fmt.Errorf("invalid YAML: %w", err)
+	}
+	if len(root.Content) == 0 {
+		return nil, fmt.Errorf("manifest is empty")
+	}
+	doc := root.Content[0]
+	if doc.Kind != yaml.MappingNode {
+		return nil, manifestError(doc, "expected a mapping with a databases list")
+	}
+
+	var list *yaml.Node
+	for i := 0; i+1 < len(doc.Content); i += 2 {
+		key, value := doc.Content[i], doc.Content[i+1]
+		if key.Value != "databases" {
+			return nil, manifestError(key, "unknown field %q", key.Value)
+		}
+		list = value
+	}
+	if list == nil {
+		return nil, manifestError(doc, "missing databases list")
+	}
+	if list.Kind != yaml.SequenceNode {
+		return nil, manifestError(list, "databases must be a list")
+	}
+
+	manifest := &dbManifest{}
+	seen := map[string]int{}
+	for _, item := range list.Content {
+		database, err := parseManifestDatabase(item)
+		if err != nil {
+			return nil, err
+		}
+		if line, ok := seen[database.Name]; ok {
+			return nil, manifestError(item, "database %s is already listed at line %d", database.Name, line)
+		}
+		seen[database.Name] = database.line
+		manifest.Databases = append(manifest.Databases, database)
+	}
+	if len(manifest.Databases) == 0 {
+		return nil, manifestError(list, "databases list is empty")
+	}
+	return manifest, nil
+}
+
+func parseManifestDatabase(node *yaml.Node) (manifestDatabase, error) {
+	database := manifestDatabase{line: node.Line}
+	if node.Kind != yaml.MappingNode {
+		return database, manifestError(node, "expected a database with a name, a location and optionally replicas and a group")
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, value := node.Content[i], node.Content[i+1]
+		switch key.Value {
+		case "name", "location", "group":
+			if value.Kind != yaml.ScalarNode {
+				return database, manifestError(value, "%s must be a string", key.Value)
+			}
+		case "replicas":
+			if value.Kind != yaml.SequenceNode {
+				return database, manifestError(value, "replicas must be a list of location IDs")
+			}
+			for _, replica := range value.Content {
+				if replica.Kind != yaml.ScalarNode {
+					return database, manifestError(replica, "replicas must be a list of location IDs")
+				}
+			}
+		default:
+			return database, manifestError(key, "unknown field %q", key.Value)
+		}
+	}
+	if err := node.Decode(&database); err != nil {
+		return database, manifestError(node, "%v", err)
+	}
+
+	if database.Name == "" {
+		return database, manifestError(node, "database is missing a name")
+	}
+	if database.Location == "" {
+		return database, manifestError(node, "database %s is missing a location", database.Name)
+	}
+	var replicas []string
+	for _, replica := range database.Replicas {
+		if replica == database.Location {
+			return database, manifestError(node, "database %s has its primary location %s listed as a replica", database.Name, replica)
+		}
+		if !slices.Contains(replicas, replica) {
+			replicas = append(replicas, replica)
+		}
+	}
+	database.Replicas = replicas
+	return database, nil
+}
+
+func manifestError(node *yaml.Node, format string, args ...interface{}) error {
+	return fmt.Errorf("line %d: %s", node.Line, fmt.Sprintf(format, args...))
+}
+
+// validateManifest checks the locations and groups of a manifest against the
+// API before anything is changed.
+func validateManifest(client *turso.Client, manifest *dbManifest) error {
+	groups, err := getGroups(client)
+	if err != nil {
+		return err
+	}
+	for _, database := range manifest.Databases {
+		for _, location := range append([]string{database.Location}, database.Replicas...) {
+			if !isValidLocation(client, location) {
+				return fmt.Errorf("line %d: %w", database.line, invalidLocationError(client, location))
+			}
+		}
+		if database.Group == "" || groupExists(groups, database.Group) {
+			continue
+		}
+		if database.Group == "default" && len(groups) == 0 {
+			continue
+		}
+		return fmt.Errorf("line %d: group %s does not exist", database.line, database.Group)
+	}
+	return nil
+}
+
+// applyDatabase creates database if current is nil and adds the replicas it
+// is missing, returning a description of what was done.
+func applyDatabase(client *turso.Client, database manifestDatabase, current *turso.Database) (string, error) {
+	var actions []string
+	if current == nil {
+		created, err := createManifestDatabase(client, database)
+		if err != nil {
+			return "", err
+		}
+		current = created
+		actions = append(actions, fmt.Sprintf("created in %s", database.Location))
+	} else if current.PrimaryRegion != database.Location {
+		return "", fmt.Errorf("primary is in %s, not in %s, and can't be moved", current.PrimaryRegion, database.Location)
+	}
+
+	missing := missingLocations(*current, database.Replicas)
+	if len(missing) > 0 {
+		if ok, _ := canReplicate(client, database.Name); !ok {
+			return "", fmt.Errorf("group %s has other databases, add %s to the group instead", current.Group, strings.Join(missing, ", "))
+		}
+		s := prompt.Spinner(fmt.Sprintf("Replicating database %s to %s...", internal.Emph(database.Name), strings.Join(missing, ", ")))
+		for _, location := range missing {
+			if _, err := createReplica(client, *current, location, ""); err != nil {
+				s.Stop()
+				return "", fmt.Errorf("could not replicate to %s: %w", location, err)
+			}
+		}
+		s.Stop()
+		actions = append(actions, fmt.Sprintf("replicated to %s", strings.Join(missing, ", ")))
+	}
+
+	if len(actions) == 0 {
+		return "up to date", nil
+	}
+	return strings.Join(actions, ", "), nil
+}
+
+func createManifestDatabase(client *turso.Client, database manifestDatabase) (*turso.Database, error) {
+	group := database.Group
+	if group == "" {
+		var err error
+		if group, err = groupFromFlag(client); err != nil {
+			return nil, err
+		}
+	}
+	if err := ensureGroup(client, group, database.Location, "latest"); err != nil {
+		return nil, err
+	}
+
+	s := prompt.Spinner(fmt.Sprintf("Creating database %s in group %s...", internal.Emph(database.Name), internal.Emph(group)))
+	defer s.Stop()
+	stopTiming := timePhase("create")
+	res, err := client.Databases.Create(database.Name, database.Location, "", "", group, "", false, nil)
+	stopTiming()
+	if err != nil {
+		return nil, fmt.Errorf("could not create database: %w", err)
+	}
+	invalidateDatabasesCache()
+	return &res.Database, nil
+}
+
+// unlistedDatabases returns the names of the databases that are not in the
+// manifest, sorted.
+func unlistedDatabases(manifest *dbManifest, databases map[string]turso.Database) []string {
+	var names []string
+	for name := range databases {
+		listed := slices.ContainsFunc(manifest.Databases, func(database manifestDatabase) bool {
+			return database.Name == name
+		})
+		if !listed {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
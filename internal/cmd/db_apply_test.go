@@ -0,0 +1,42 @@
+package cmd
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseManifest(t *testing.T) {
+	manifest, err := parseManifest([]byte(manifestExample))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []manifestDatabase{
+		{Name: "users", Location: "ams", Replicas: []string{"gru", "iad"}, line: 2},
+		{Name: "orders", Location: "iad", Group: "default", line: 5},
+	}
+	if !reflect.DeepEqual(manifest.Databases, expected) {
+		t.Fatalf("expected %+v, got %+v", expected, manifest.Databases)
+	}
+
+	tests := []struct {
+		manifest string
+		err      string
+	}{
+		{"", "manifest is empty"},
+		{"dbs: []", `line 1: unknown field "dbs"`},
+		{"databases: users", "line 1: databases must be a list"},
+		{"databases:\n  - name: users\n    region: ams", `line 3: unknown field "region"`},
+		{"databases:\n  - name: users", "line 2: database users is missing a location"},
+		{"databases:\n  - location: ams", "line 2: database is missing a name"},
+		{"databases:\n  - name: users\n    location: ams\n    replicas: gru", "line 4: replicas must be a list of location IDs"},
+		{"databases:\n  - name: users\n    location: ams\n    replicas: [ams]", "line 2: database users has its primary location ams listed as a replica"},
+		{"databases:\n  - name: users\n    location: ams\n  - name: users\n    location: gru", "line 4: database users is already listed at line 2"},
+	}
+	for _, test := range tests {
+		_, err := parseManifest([]byte(test.manifest))
+		if err == nil || !strings.Contains(err.Error(), test.err) {
+			t.Errorf("%q: expected error %q, got %v", test.manifest, test.err, err)
+		}
+	}
+}
@@ -0,0 +1,167 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+	"github.com/tursodatabase/turso-cli/internal"
+	"github.com/tursodatabase/turso-cli/internal/prompt"
+)
+
+const backupTimestampFormat = "20060102T150405Z"
+
+var (
+	backupOutDirFlag string
+	backupKeepFlag   int
+)
+
+func init() {
+	dbCmd.AddCommand(backupCmd)
+	backupCmd.Flags().StringVar(&backupOutDirFlag, "out-dir", ".", "Directory where the backup files are written.")
+	backupCmd.Flags().IntVar(&backupKeepFlag, "keep", 0, "Number of most recent backups of the database to keep in the output directory. 0 keeps all of them.")
+}
+
+var backupCmd = &cobra.Command{
+	Use:               "backup <database-name>",
+	Short:             "Write a timestamped SQL dump of a database to a directory.",
+	Example:           "  turso db backup name-of-my-amazing-db --out-dir ./backups --keep 7",
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: dbNameArg,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if backupKeepFlag < 0 {
+			return fmt.Errorf("--keep must be zero or a positive number")
+		}
+		cmd.SilenceUsage = true
+
+		client, err := authedTursoClient()
+		if err != nil {
+			return err
+		}
+
+		db, err := databaseFromName(args[0], client)
+		if err != nil {
+			return err
+		}
+
+		token, err := tokenFromDb(db, client, nil)
+		if err != nil {
+			return err
+		}
+
+		if backupOutDirFlag, err = resolvePath(backupOutDirFlag); err != nil {
+			return err
+		}
+		if err := os.MkdirAll(backupOutDirFlag, 0o755); err != nil {
+			return fmt.Errorf("could not create output directory %s: %w", backupOutDirFlag, err)
+		}
+
+		spinner := prompt.Spinner(fmt.Sprintf("Backing up database %s...", internal.Emph(db.Name)))
+		defer spinner.Stop()
+
+		path, size, err := writeBackup(getDatabaseHttpUrl(db), token, db.Name, backupOutDirFlag)
+		if err != nil {
+			return err
+		}
+
+		removed, err := pruneBackups(backupOutDirFlag, db.Name, backupKeepFlag)
+		if err != nil {
+			return err
+		}
+
+		spinner.Stop()
+		fmt.Printf("Backed up database %s to %s (%s).\n", internal.Emph(db.Name), internal.Emph(path), humanize.Bytes(uint64(size)))
+		if removed > 0 {
+			fmt.Printf("Removed %d old backups.\n", removed)
+		}
+		return nil
+	},
+}
+
+func backupPrefix(dbName string) string {
+	return dbName + "-"
+}
+
+// writeBackup dumps the database into a timestamped file in dir. The dump is
+// written to a temporary file first so an interrupted backup never looks like
+// a complete one.
+func writeBackup(dbURL, token, dbName, dir string) (string, int64, error) {
+	name := fmt.Sprintf("%s%s.sql", backupPrefix(dbName), time.Now().UTC().Format(backupTimestampFormat))
+	path := filepath.Join(dir, name)
+
+	tmp, err := os.CreateTemp(dir, "."+name+".*")
+	if err != nil {
+		return "", 0, fmt.Errorf("could not create backup file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if err := dumpTo(tmp, dbURL, token); err != nil {
+		tmp.Close()
+		return "", 0, fmt.Errorf("could not dump database %s: %w", dbName, err)
+	}
+
+	stat, err := tmp.Stat()
+	if err != nil {
+		tmp.Close()
+		return "", 0, fmt.Errorf("could not stat backup file: %w", err)
+	}
+
+	if err := tmp.Close(); err != nil {
+		return "", 0, fmt.Errorf("could not write backup file: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return "", 0, fmt.Errorf("could not write backup file %s: %w", path, err)
+	}
+	return path, stat.Size(), nil
+}
+
+// pruneBackups removes the oldest backups of dbName in dir, keeping the last
+// keep ones. It returns how many files were removed.
+func pruneBackups(dir, dbName string, keep int) (int, error) {
+	if keep == 0 {
+		return 0, nil
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return 0, fmt.Errorf("could not list backups in %s: %w", dir, err)
+	}
+
+	backups := []string{}
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !isBackupOf(name, dbName) {
+			continue
+		}
+		backups = append(backups, name)
+	}
+
+	if len(backups) <= keep {
+		return 0, nil
+	}
+
+	// timestamps sort lexicographically, so the oldest backups come first
+	sort.Strings(backups)
+	stale := backups[:len(backups)-keep]
+	for _, name := range stale {
+		if err := os.Remove(filepath.Join(dir, name)); err != nil {
+			return 0, fmt.Errorf("could not remove old backup %s: %w", name, err)
+		}
+	}
+	return len(stale), nil
+}
+
+func isBackupOf(name, dbName string) bool {
+	if !strings.HasPrefix(name, backupPrefix(dbName)) || !strings.HasSuffix(name, ".sql") {
+		return false
+	}
+	timestamp := strings.TrimSuffix(strings.TrimPrefix(name, backupPrefix(dbName)), ".sql")
+	_, err := time.Parse(backupTimestampFormat, timestamp)
+	return err == nil
+}
@@ -0,0 +1,88 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/slices"
+)
+
+func TestPruneBackups(t *testing.T) {
+	files := []string{
+		"db-20240101T000000Z.sql",
+		"db-20240102T000000Z.sql",
+		"db-20240103T000000Z.sql",
+		"db-2-20240101T000000Z.sql",
+		"db-2-20240102T000000Z.sql",
+		"db-notes.sql",
+		"db-20240104T000000Z.sql.bak",
+		"notes.txt",
+	}
+	setup := func(t *testing.T) string {
+		dir := t.TempDir()
+		for _, name := range files {
+			if err := os.WriteFile(filepath.Join(dir, name), nil, 0o644); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := os.Mkdir(filepath.Join(dir, "db-20240105T000000Z.sql"), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		return dir
+	}
+	remaining := func(t *testing.T, dir string) []string {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		names := make([]string, 0, len(entries))
+		for _, entry := range entries {
+			if !entry.IsDir() {
+				names = append(names, entry.Name())
+			}
+		}
+		sort.Strings(names)
+		return names
+	}
+
+	tests := []struct {
+		name    string
+		dbName  string
+		keep    int
+		removed []string
+	}{
+		{name: "keep one", dbName: "db", keep: 1, removed: []string{"db-20240101T000000Z.sql", "db-20240102T000000Z.sql"}},
+		{name: "database name is a prefix", dbName: "db-2", keep: 1, removed: []string{"db-2-20240101T000000Z.sql"}},
+		{name: "keep more than there are", dbName: "db", keep: 5},
+		{name: "keep all", dbName: "db", keep: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := setup(t)
+			n, err := pruneBackups(dir, tt.dbName, tt.keep)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if n != len(tt.removed) {
+				t.Errorf("expected %d backups removed, got %d", len(tt.removed), n)
+			}
+
+			want := []string{}
+			for _, name := range files {
+				if !slices.Contains(tt.removed, name) {
+					want = append(want, name)
+				}
+			}
+			sort.Strings(want)
+			if got := remaining(t, dir); !reflect.DeepEqual(got, want) {
+				t.Errorf("expected %v to remain, got %v", want, got)
+			}
+			if _, err := os.Stat(filepath.Join(dir, "db-20240105T000000Z.sql")); err != nil {
+				t.Errorf("expected the directory to be kept: %v", err)
+			}
+		})
+	}
+}
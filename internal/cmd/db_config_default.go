@@ -0,0 +1,83 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/tursodatabase/turso-cli/internal"
+	"github.com/tursodatabase/turso-cli/internal/settings"
+)
+
+func init() {
+	dbConfigCmd.AddCommand(dbConfigSetDefaultCmd)
+	dbConfigCmd.AddCommand(dbConfigUnsetDefaultCmd)
+}
+
+var dbConfigSetDefaultCmd = &cobra.Command{
+	Use:               "set-default <database-name>",
+	Short:             "Use a database when no name is given to db shell and db show",
+	Example:           "  turso db config set-default my-db\n  turso db shell\n  turso db show --url",
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: dbNameArg,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+		client, err := authedTursoClient()
+		if err != nil {
+			return err
+		}
+		db, err := getDatabase(client, args[0], true)
+		if err != nil {
+			return err
+		}
+
+		config, err := settings.ReadSettings()
+		if err != nil {
+			return fmt.Errorf("failed to read settings: %w", err)
+		}
+		config.SetDefaultDatabase(db.Name)
+		if err := settings.TryToPersistChanges(); err != nil {
+			return err
+		}
+		fmt.Printf("Default database set to %s.\n", internal.Emph(db.Name))
+		return nil
+	},
+}
+
+var dbConfigUnsetDefaultCmd = &cobra.Command{
+	Use:               "unset-default",
+	Short:             "Stop using a default database",
+	Args:              cobra.NoArgs,
+	ValidArgsFunction: cobra.NoFileCompletions,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		config, err := settings.ReadSettings()
+		if err != nil {
+			return fmt.Errorf("failed to read settings: %w", err)
+		}
+		if !config.UnsetDefaultDatabase() {
+			fmt.Println("No default database set.")
+			return nil
+		}
+		if err := settings.TryToPersistChanges(); err != nil {
+			return err
+		}
+		fmt.Println("Default database unset.")
+		return nil
+	},
+}
+
+// withDefaultDatabase returns args, or the default database set with turso db
+// config set-default when args is empty.
+func withDefaultDatabase(args []string) ([]string, error) {
+	if len(args) > 0 {
+		return args, nil
+	}
+	config, err := settings.ReadSettings()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read settings: %w", err)
+	}
+	name := config.DefaultDatabase()
+	if name == "" {
+		return nil, fmt.Errorf("please specify a database name, or set a default one with %s", internal.Emph("turso db config set-default <database-name>"))
+	}
+	return []string{name}, nil
+}
@@ -1,7 +1,11 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
+	"io"
+	"os"
+	"strings"
 	"time"
 
 	"github.com/athoscouto/codename"
@@ -10,6 +14,7 @@ import (
 	"github.com/tursodatabase/turso-cli/internal/flags"
 	"github.com/tursodatabase/turso-cli/internal/prompt"
 	"github.com/tursodatabase/turso-cli/internal/turso"
+	"golang.org/x/exp/slices"
 )
 
 const MaxDumpFileSizeBytes = 8 << 30
@@ -22,22 +27,62 @@ func init() {
 	addDbFromDumpURLFlag(createCmd)
 	addDbFromFileFlag(createCmd)
 	addDbFromCSVFlag(createCmd)
+	addDbFromURLFlag(createCmd)
 	addCSVTableNameFlag(createCmd)
 	flags.AddCSVSeparator(createCmd)
 	addLocationFlag(createCmd, "Location ID. If no ID is specified, closest location to you is used by default.")
+	addLocationGroupFlag(createCmd, "Create the database in the locations of these comma-separated location groups. The primary is in --location, or else in the first location of the groups.")
 	addWaitFlag(createCmd, "Wait for the database to be ready to receive requests.")
 	addCanaryFlag(createCmd)
 	addEnableExtensionsFlag(createCmd)
 	addSchemaFlag(createCmd)
+	addSchemaDBFlag(createCmd)
+	addSeedRowsFlag(createCmd)
 	addTypeFlag(createCmd)
+	addOutputFlag(createCmd)
+	addDescriptionFlag(createCmd)
+	createCmd.Flags().BoolVar(&outputEnvFlag, "output-env", false, "Print shell export lines for the database URL and a new auth token instead of the usual output, to be used as: eval \"$(turso db create my-db --output-env)\"")
+	createCmd.MarkFlagsMutuallyExclusive("output-env", "output")
+	createCmd.Flags().DurationVar(&createTimeoutFlag, "timeout", 10*time.Minute, "Maximum time to wait for the database to be ready with --wait, or before copying the schema given with --schema-db or applying the dump given with --from-url.")
+	createCmd.Flags().BoolVar(&noProbeFlag, "no-probe", false, "Trust the location given with --location without checking it against the list of locations. Invalid locations fail when creating the database.")
+	createCmd.Flags().BoolVar(&idempotentFlag, "idempotent", false, "Do not fail if the database already exists in the requested location. Details of the existing database are printed instead.")
+	createCmd.Flags().BoolVar(&ifNotExistsFlag, "if-not-exists", false, "Do nothing and print nothing if a database with this name already exists, wherever it is. Unlike --idempotent, the location is not checked and no details are printed.")
+	createCmd.MarkFlagsMutuallyExclusive("if-not-exists", "idempotent")
+	createCmd.MarkFlagsMutuallyExclusive("if-not-exists", "output-env")
+}
+
+var (
+	idempotentFlag    bool
+	ifNotExistsFlag   bool
+	outputEnvFlag     bool
+	noProbeFlag       bool
+	createTimeoutFlag time.Duration
+)
+
+// createResult is the JSON output of db create --wait.
+type createResult struct {
+	databaseInfo
+	Ready            bool    `json:"ready"`
+	ProvisionSeconds float64 `json:"provision_seconds"`
+	Status           string  `json:"status,omitempty"`
 }
 
 var createCmd = &cobra.Command{
 	Use:               "create [flags] [database-name]",
+	Aliases:           []string{"mk", "new"},
 	Short:             "Create a database.",
 	Args:              cobra.MaximumNArgs(1),
 	ValidArgsFunction: noFilesArg,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := validateOutputFlag(); err != nil {
+			return err
+		}
+		if noProbeFlag && locationFlag == "" {
+			return fmt.Errorf("--no-probe requires --location, since the closest location can't be found without probing")
+		}
+		if ifNotExistsFlag && len(args) == 0 {
+			return fmt.Errorf("--if-not-exists requires a database name")
+		}
 		cmd.SilenceUsage = true
 		name, err := getDatabaseName(args)
 		if err != nil {
@@ -49,24 +94,85 @@ var createCmd = &cobra.Command{
 			return err
 		}
 
+		if ifNotExistsFlag {
+			databases, err := getDatabasesMap(client, true)
+			if err != nil {
+				return err
+			}
+			if _, ok := databases[name]; ok {
+				return nil
+			}
+		}
+
+		var groupLocations []string
+		if locationGroupFlag != "" {
+			if jsonOutput() {
+				return fmt.Errorf("--location-group can't be used with --output json")
+			}
+			if outputEnvFlag {
+				return fmt.Errorf("--location-group can't be used with --output-env")
+			}
+			if groupLocations, err = locationGroupLocations(client, locationGroupFlag); err != nil {
+				return err
+			}
+		}
+
+		location := ""
+		if locationFlag == "" && len(groupLocations) > 0 {
+			location = groupLocations[0]
+		} else if location, err = locationFromFlag(client); err != nil {
+			return err
+		}
+
+		if idempotentFlag {
+			existing, err := existingDatabase(client, name)
+			if err != nil {
+				return err
+			}
+			if existing != nil {
+				return printExistingDatabase(client, *existing)
+			}
+		}
+
 		group, err := groupFromFlag(client)
 		if err != nil {
 			return err
 		}
 
-		location, err := locationFromFlag(client)
-		if err != nil {
+		if err := validateSeedRows(); err != nil {
 			return err
 		}
+		if fromURLFlag != "" {
+			if countFlags(fromDBFlag, fromDumpFlag, fromFileFlag, fromDumpURLFlag, fromCSVFlag, schemaDBFlag) > 0 {
+				return fmt.Errorf("--from-url can't be used together with --schema-db or the other --from prefixed flags")
+			}
+			if err := validateFromURL(fromURLFlag); err != nil {
+				return err
+			}
+		}
 
 		seed, err := parseDBSeedFlags(client)
 		if err != nil {
 			return err
 		}
 
-		version := "latest"
-		if canaryFlag {
-			version = "canary"
+		var schema []string
+		if schemaDBFlag != "" {
+			if seed != nil {
+				return fmt.Errorf("--schema-db can't be used together with the --from prefixed flags")
+			}
+			if schema, err = readSchema(client, schemaDBFlag); err != nil {
+				return err
+			}
+		}
+
+		image, err := imageFromFlags()
+		if err != nil {
+			return err
+		}
+		version := image
+		if version == "" {
+			version = "latest"
 		}
 
 		if err := ensureGroup(client, group, location, version); err != nil {
@@ -74,33 +180,212 @@ var createCmd = &cobra.Command{
 		}
 
 		start := time.Now()
-		spinner := prompt.Spinner(fmt.Sprintf("Creating database %s in group %s...", internal.Emph(name), internal.Emph(group)))
+		spinner := prompt.StoppedSpinner(fmt.Sprintf("Creating database %s in group %s...", internal.Emph(name), internal.Emph(group)))
+		if !jsonOutput() && !outputEnvFlag {
+			spinner.Start()
+		}
 		defer spinner.Stop()
 
-		if _, err = client.Databases.Create(name, location, "", "", group, schemaFlag, typeFlag == "schema", seed); err != nil {
+		stopTiming := timePhase("create")
+		res, err := client.Databases.Create(name, location, "", "", group, schemaFlag, typeFlag == "schema", seed)
+		stopTiming()
+		if err != nil {
 			return fmt.Errorf("could not create database %s: %w", name, err)
 		}
 
-		spinner.Stop()
-		elapsed := time.Since(start)
-		fmt.Printf("Created database %s at group %s in %s.\n\n", internal.Emph(name), internal.Emph(group), elapsed.Round(time.Millisecond).String())
-
-		fmt.Printf("Start an interactive SQL shell with:\n\n")
-		fmt.Printf("   %s\n\n", internal.Emph("turso db shell "+name))
-		fmt.Printf("To see information about the database, including a connection URL, run:\n\n")
-		fmt.Printf("   %s\n\n", internal.Emph("turso db show "+name))
-		fmt.Printf("To get an authentication token for the database, run:\n\n")
-		fmt.Printf("   %s\n\n", internal.Emph("turso db tokens create "+name))
 		invalidateDatabasesCache()
+		if descriptionFlag != "" {
+			if err := setDatabaseDescription(res.Database.ID, descriptionFlag); err != nil {
+				warnf("created database %s, but could not save its description: %v", name, err)
+			}
+		}
+		if len(schema) > 0 {
+			if err := applySchema(client, res.Database, schema, spinner.Text); err != nil {
+				return fmt.Errorf("created database %s, but could not copy the schema of %s: %w", name, schemaDBFlag, err)
+			}
+		}
+		if fromURLFlag != "" {
+			if err := seedFromURL(client, res.Database, fromURLFlag, spinner.Text); err != nil {
+				return fmt.Errorf("created database %s, but could not seed it from %s: %w", name, fromURLFlag, err)
+			}
+		}
+		if seedRowsFlag > 0 {
+			table, err := seedRows(client, res.Database, seedRowsFlag, spinner.Text)
+			if err != nil {
+				return fmt.Errorf("created database %s, but could not insert generated rows: %w", name, err)
+			}
+			if !jsonOutput() && !outputEnvFlag {
+				spinner.Stop()
+				fmt.Printf("Inserted %d generated rows into table %s.\n", seedRowsFlag, internal.Emph(table))
+				if waitFlag {
+					spinner.Start()
+				}
+			}
+		}
+
+		replicas := slices.DeleteFunc(slices.Clone(groupLocations), func(l string) bool { return l == location })
+		if len(replicas) > 0 {
+			spinner.Stop()
+			if err := replicateCreatedDatabase(client, res.Database, replicas, image); err != nil {
+				return err
+			}
+			if waitFlag {
+				spinner.Start()
+			}
+		}
+
+		if waitFlag {
+			return waitForCreatedDatabase(client, res.Database, group, start, len(replicas) > 0, spinner.Text, spinner.Stop)
+		}
+
+		spinner.Stop()
+		if jsonOutput() {
+			return printJSON(newDatabaseInfo(res.Database))
+		}
+		if outputEnvFlag {
+			return printDatabaseEnv(client, res.Database)
+		}
+
+		printCreated(client, res.Database, group, time.Since(start), len(replicas) > 0)
 		return nil
 	},
 }
 
+// waitForCreatedDatabase waits until db answers queries, then prints the
+// result of the creation. With JSON output the result says whether the
+// database became ready and how long it took, even if it timed out.
+func waitForCreatedDatabase(client *turso.Client, db turso.Database, group string, start time.Time, replicated bool, status func(string), stop func()) error {
+	token, err := tokenFromDb(&db, client, nil)
+	if err != nil {
+		return err
+	}
+
+	status(fmt.Sprintf("Waiting for database %s to be ready...", internal.Emph(db.Name)))
+	ctx, cancel := context.WithTimeout(commandContext(), createTimeoutFlag)
+	defer cancel()
+	last, waitErr := waitUntilReady(ctx, getDatabaseHttpUrl(&db), token)
+	elapsed := time.Since(start)
+	stop()
+
+	if waitErr != nil {
+		waitErr = fmt.Errorf("database %s was created, but was not ready after %s: %w", db.Name, createTimeoutFlag, waitErr)
+	}
+	if jsonOutput() {
+		result := createResult{
+			databaseInfo:     newDatabaseInfo(db),
+			Ready:            waitErr == nil,
+			ProvisionSeconds: elapsed.Seconds(),
+			Status:           last,
+		}
+		if err := printJSON(result); err != nil {
+			return err
+		}
+		return waitErr
+	}
+	if waitErr != nil {
+		return waitErr
+	}
+	if outputEnvFlag {
+		return printDatabaseEnv(client, db)
+	}
+
+	printCreated(client, db, group, elapsed, replicated)
+	return nil
+}
+
+// printCreated prints the summary of a new database and how to use it. The
+// locations it spans are printed too, unless replicating it to them already
+// did.
+func printCreated(client *turso.Client, db turso.Database, group string, elapsed time.Duration, replicated bool) {
+	fmt.Printf("Created database %s at group %s in %s.\n\n", internal.Emph(db.Name), internal.Emph(group), elapsed.Round(time.Millisecond).String())
+	if !replicated {
+		printTopology(client, db.Name)
+	}
+	printCreateHints(db.Name)
+}
+
+// replicateCreatedDatabase replicates a new database to the other locations
+// of its --location-group.
+func replicateCreatedDatabase(client *turso.Client, db turso.Database, locations []string, image string) error {
+	if ok, _ := canReplicate(client, db.Name); !ok {
+		return fmt.Errorf("created database %s, but did not replicate it because group %s has other databases.\nUse %s to add the locations to the group instead", db.Name, internal.Emph(db.Group), internal.Emph("turso group locations add"))
+	}
+	if err := replicateToLocations(client, db, locations, image, flags.MaxConcurrency()); err != nil {
+		return fmt.Errorf("created database %s, but %w", db.Name, err)
+	}
+	fmt.Println()
+	return nil
+}
+
+// printDatabaseEnv prints shell export lines with the URL of db and a new
+// non-expiring token for it.
+func printDatabaseEnv(client *turso.Client, db turso.Database) error {
+	token, err := client.Databases.Token(db.Name, "never", false, nil)
+	if err != nil {
+		return fmt.Errorf("could not create a token for database %s: %w", db.Name, err)
+	}
+	fmt.Printf("export TURSO_DATABASE_URL=%s\n", shellQuote(getDatabaseUrl(&db)))
+	fmt.Printf("export TURSO_AUTH_TOKEN=%s\n", shellQuote(token))
+	return nil
+}
+
+// shellQuote quotes s so that a POSIX shell reads it as a single word.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+func printCreateHints(name string) {
+	fmt.Printf("Start an interactive SQL shell with:\n\n")
+	fmt.Printf("   %s\n\n", internal.Emph("turso db shell "+name))
+	fmt.Printf("To see information about the database, including a connection URL, run:\n\n")
+	fmt.Printf("   %s\n\n", internal.Emph("turso db show "+name))
+	fmt.Printf("To get an authentication token for the database, run:\n\n")
+	fmt.Printf("   %s\n\n", internal.Emph("turso db tokens create "+name))
+}
+
+// existingDatabase returns the database with the given name if it already
+// exists, failing if it lives in a location other than the requested one.
+func existingDatabase(client *turso.Client, name string) (*turso.Database, error) {
+	databases, err := getDatabasesMap(client, true)
+	if err != nil {
+		return nil, err
+	}
+
+	db, ok := databases[name]
+	if !ok {
+		return nil, nil
+	}
+
+	if locationFlag != "" && db.PrimaryRegion != locationFlag {
+		return nil, fmt.Errorf("database %s already exists in location %s, not in %s", internal.Emph(name), internal.Emph(db.PrimaryRegion), internal.Emph(locationFlag))
+	}
+	return &db, nil
+}
+
+func printExistingDatabase(client *turso.Client, db turso.Database) error {
+	if jsonOutput() {
+		return printJSON(newDatabaseInfo(db))
+	}
+	if outputEnvFlag {
+		return printDatabaseEnv(client, db)
+	}
+
+	fmt.Printf("Database %s already exists at group %s, skipping creation.\n\n", internal.Emph(db.Name), internal.Emph(formatGroup(db.Group)))
+	printCreateHints(db.Name)
+	return nil
+}
+
+// ensureGroup creates the default group when there are no groups yet. The
+// summary goes to stderr when stdout is meant for JSON or --output-env.
 func ensureGroup(client *turso.Client, group, location, version string) error {
 	if ok, err := shouldCreateGroup(client, group, location); !ok {
 		return err
 	}
-	if err := createGroup(client, group, location, version); err != nil {
+	var w io.Writer = os.Stdout
+	if jsonOutput() || outputEnvFlag {
+		w = os.Stderr
+	}
+	if err := createGroup(client, group, location, version, w); err != nil {
 		return err
 	}
 	return client.Groups.WaitLocation(group, location)
@@ -152,12 +437,18 @@ func groupExists(groups []turso.Group, name string) bool {
 }
 
 func locationFromFlag(client *turso.Client) (string, error) {
+	if noProbeFlag {
+		return locationFlag, nil
+	}
 	loc := locationFlag
 	if loc == "" {
-		loc, _ = closestLocation(client)
+		var err error
+		if loc, err = closestLocation(client); err != nil {
+			warnf("could not find the closest location, using %s: %v", loc, err)
+		}
 	}
 	if !isValidLocation(client, loc) {
-		return "", fmt.Errorf("location '%s' is not valid", loc)
+		return "", invalidLocationError(client, loc)
 	}
 	return loc, nil
 }
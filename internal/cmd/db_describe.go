@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/tursodatabase/turso-cli/internal"
+	"github.com/tursodatabase/turso-cli/internal/settings"
+)
+
+var descriptionFlag string
+
+func init() {
+	dbCmd.AddCommand(dbDescribeCmd)
+}
+
+func addDescriptionFlag(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&descriptionFlag, "description", "", "A note about what the database is for, shown by db show and db list --wide. It is kept in your local settings.")
+}
+
+var dbDescribeCmd = &cobra.Command{
+	Use:               "describe <database-name> [description]",
+	Short:             "Show or change the description of a database.",
+	Long:              "Show or change the description of a database.\nDescriptions are kept in your local settings, so they are only seen on this machine. An empty description removes it.",
+	Example:           "  turso db describe my-db \"prod users db\"\n  turso db describe my-db\n  turso db describe my-db \"\"",
+	Args:              cobra.RangeArgs(1, 2),
+	ValidArgsFunction: dbNameArg,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+		client, err := authedTursoClient()
+		if err != nil {
+			return err
+		}
+		db, err := getDatabase(client, args[0], true)
+		if err != nil {
+			return err
+		}
+
+		if len(args) == 1 {
+			if description := databaseDescription(db.ID); description != "" {
+				fmt.Println(description)
+				return nil
+			}
+			fmt.Printf("Database %s has no description. Set one with %s\n", internal.Emph(db.Name), internal.Emph("turso db describe "+db.Name+" <description>"))
+			return nil
+		}
+
+		if err := setDatabaseDescription(db.ID, args[1]); err != nil {
+			return err
+		}
+		if args[1] == "" {
+			fmt.Printf("Description of database %s removed.\n", internal.Emph(db.Name))
+			return nil
+		}
+		fmt.Printf("Description of database %s set.\n", internal.Emph(db.Name))
+		return nil
+	},
+}
+
+func databaseDescription(id string) string {
+	config, err := settings.ReadSettings()
+	if err != nil {
+		return ""
+	}
+	return config.DatabaseDescription(id)
+}
+
+func setDatabaseDescription(id, description string) error {
+	config, err := settings.ReadSettings()
+	if err != nil {
+		return fmt.Errorf("failed to read settings: %w", err)
+	}
+	config.SetDatabaseDescription(id, description)
+	return settings.TryToPersistChanges()
+}
@@ -3,11 +3,13 @@ package cmd
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/tursodatabase/turso-cli/internal"
 	"github.com/tursodatabase/turso-cli/internal/turso"
+	"golang.org/x/exp/slices"
 )
 
 func init() {
@@ -20,6 +22,7 @@ func init() {
 
 var destroyCmd = &cobra.Command{
 	Use:               "destroy <database-name>",
+	Aliases:           []string{"rm"},
 	Short:             "Destroy a database.",
 	Args:              cobra.MinimumNArgs(1),
 	ValidArgsFunction: dbNameArg,
@@ -42,9 +45,9 @@ var destroyCmd = &cobra.Command{
 func handleDestroySingleDB(args []string, client *turso.Client) error {
 	name := args[0]
 
-	db, err := getDatabase(client, name)
+	db, err := getDatabase(client, name, locationFlag != "")
 	if err != nil {
-		return nil
+		return err
 	}
 
 	if instanceFlag != "" {
@@ -58,6 +61,9 @@ func handleDestroySingleDB(args []string, client *turso.Client) error {
 		if db.Group != "" {
 			return fmt.Errorf("group databases do not support location destruction.\nUse %s instead", internal.Emph("turso group locations rm "+name+" "+locationFlag))
 		}
+		if err := checkDatabaseLocation(db, locationFlag); err != nil {
+			return err
+		}
 		return destroyDatabaseRegion(client, name, locationFlag)
 	}
 
@@ -65,7 +71,7 @@ func handleDestroySingleDB(args []string, client *turso.Client) error {
 		return destroyDatabases(client, args)
 	}
 
-	fmt.Printf("Database %s and all its data will be destroyed.\n", internal.Emph(name))
+	printInstancesToDestroy(client, db)
 
 	ok, err := promptConfirmation("Are you sure you want to do this?")
 	if err != nil {
@@ -80,6 +86,45 @@ func handleDestroySingleDB(args []string, client *turso.Client) error {
 	return destroyDatabases(client, args)
 }
 
+// printInstancesToDestroy tells which instances will be destroyed with db.
+// The instances of a database in a group belong to the group and stay, so
+// they are only listed for databases outside of groups.
+func printInstancesToDestroy(client *turso.Client, db turso.Database) {
+	var instances []turso.Instance
+	if db.Group == "" {
+		instances, _ = client.Instances.List(db.Name)
+	}
+	if len(instances) == 0 {
+		fmt.Printf("Database %s and all its data will be destroyed.\n", internal.Emph(db.Name))
+		return
+	}
+
+	sort.Slice(instances, func(i, j int) bool {
+		if instances[i].Type != instances[j].Type {
+			return instances[i].Type == "primary"
+		}
+		return instances[i].Region < instances[j].Region
+	})
+	fmt.Printf("Database %s and all its data will be destroyed, with its %d instances:\n\n", internal.Emph(db.Name), len(instances))
+	data := make([][]string, 0, len(instances))
+	for _, instance := range instances {
+		data = append(data, []string{instance.Name, instance.Type, instance.Region})
+	}
+	printTable([]string{"Name", "Type", "Location"}, data)
+	fmt.Println()
+}
+
+// checkDatabaseLocation returns an error listing the locations of db if it has
+// no instance in location.
+func checkDatabaseLocation(db turso.Database, location string) error {
+	if slices.Contains(db.Regions, location) {
+		return nil
+	}
+	locations := slices.Clone(db.Regions)
+	sort.Strings(locations)
+	return fmt.Errorf("database %s has no instances in location %s. Its locations are: %s", internal.Emph(db.Name), internal.Emph(location), strings.Join(locations, ", "))
+}
+
 func handleDestroyMultipleDBs(args []string, client *turso.Client) error {
 	if instanceFlag != "" || locationFlag != "" {
 		return errors.New("can not use location nor instance flag when deleting more than 1 database")
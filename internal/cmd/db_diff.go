@@ -0,0 +1,188 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/tursodatabase/turso-cli/internal"
+	"github.com/tursodatabase/turso-cli/internal/prompt"
+	"github.com/tursodatabase/turso-cli/internal/turso"
+	"golang.org/x/exp/slices"
+)
+
+var diffRowCountsFlag bool
+
+// errDatabasesDiffer is returned by db diff when the databases differ, to tell
+// it apart from the errors that kept them from being compared.
+var errDatabasesDiffer = errors.New("databases differ")
+
+func init() {
+	dbCmd.AddCommand(diffCmd)
+	diffCmd.Flags().BoolVar(&diffRowCountsFlag, "row-counts", false, "Also compare the number of rows in each table. Only the schemas are compared by default.")
+}
+
+var diffCmd = &cobra.Command{
+	Use:               "diff <database-name> <other-database-name>",
+	Short:             "Compare the schemas of two databases.",
+	Long:              "Compare the schemas of two databases, printing the differences as a unified diff.\nExits with status 1 if the databases differ and 2 if they could not be compared, like diff(1).",
+	Example:           "  turso db diff production staging\n  turso db diff production staging --row-counts",
+	Args:              cobra.ExactArgs(2),
+	ValidArgsFunction: dbNameArg,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+		client, err := authedTursoClient()
+		if err != nil {
+			return err
+		}
+
+		spinner := prompt.Spinner(fmt.Sprintf("Comparing databases %s and %s...", internal.Emph(args[0]), internal.Emph(args[1])))
+		defer spinner.Stop()
+
+		schemaA, err := schemaLines(client, args[0])
+		if err != nil {
+			return err
+		}
+		schemaB, err := schemaLines(client, args[1])
+		if err != nil {
+			return err
+		}
+		diff := unifiedDiff(args[0], args[1], schemaA, schemaB)
+
+		var counts [][]string
+		if diffRowCountsFlag {
+			if counts, err = rowCountDifferences(client, args[0], args[1]); err != nil {
+				return err
+			}
+		}
+		spinner.Stop()
+
+		if diff == "" && len(counts) == 0 {
+			fmt.Printf("Databases %s and %s are the same.\n", internal.Emph(args[0]), internal.Emph(args[1]))
+			return nil
+		}
+		if diff != "" {
+			fmt.Print(diff)
+		}
+		if len(counts) > 0 {
+			if diff != "" {
+				fmt.Println()
+			}
+			printTable([]string{"Table", args[0], args[1]}, counts)
+		}
+		return fmt.Errorf("%w: %s and %s", errDatabasesDiffer, args[0], args[1])
+	},
+}
+
+// diffExitCode returns the exit status of a db diff that failed with err: 1
+// when the databases differ and 2 when they could not be compared.
+func diffExitCode(err error) int {
+	if errors.Is(err, errDatabasesDiffer) {
+		return 1
+	}
+	return 2
+}
+
+// schemaLines returns the schema of a database as sorted lines, so that
+// objects created in a different order don't show up as differences.
+func schemaLines(client *turso.Client, name string) ([]string, error) {
+	statements, err := readSchema(client, name)
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(statements)
+
+	var lines []string
+	for _, statement := range statements {
+		lines = append(lines, strings.Split(strings.TrimSpace(statement)+";", "\n")...)
+	}
+	return lines, nil
+}
+
+// rowCountDifferences returns the tables whose number of rows is different in
+// the two databases, with their counts. Tables missing from a database have a
+// count of "-".
+func rowCountDifferences(client *turso.Client, nameA, nameB string) ([][]string, error) {
+	countsA, err := rowCounts(client, nameA)
+	if err != nil {
+		return nil, err
+	}
+	countsB, err := rowCounts(client, nameB)
+	if err != nil {
+		return nil, err
+	}
+
+	tables := make([]string, 0, len(countsA)+len(countsB))
+	for table := range countsA {
+		tables = append(tables, table)
+	}
+	for table := range countsB {
+		if _, ok := countsA[table]; !ok {
+			tables = append(tables, table)
+		}
+	}
+	slices.Sort(tables)
+
+	var differences [][]string
+	for _, table := range tables {
+		countA, okA := countsA[table]
+		countB, okB := countsB[table]
+		if okA && okB && countA == countB {
+			continue
+		}
+		differences = append(differences, []string{table, formatRowCount(countA, okA), formatRowCount(countB, okB)})
+	}
+	return differences, nil
+}
+
+func rowCounts(client *turso.Client, name string) (map[string]string, error) {
+	db, err := getDatabase(client, name)
+	if err != nil {
+		return nil, err
+	}
+	token, err := tokenFromDb(&db, client, nil)
+	if err != nil {
+		return nil, err
+	}
+	dbURL := getDatabaseHttpUrl(&db)
+
+	tables, err := listTables(dbURL, token)
+	if err != nil {
+		return nil, fmt.Errorf("could not list tables of database %s: %w", name, err)
+	}
+	if len(tables) == 0 {
+		return map[string]string{}, nil
+	}
+
+	statements := make([]string, 0, len(tables))
+	for _, table := range tables {
+		statements = append(statements, "SELECT COUNT(*) FROM "+quoteIdentifier(table))
+	}
+	results, err := executeStatements(dbURL, token, statements)
+	if err != nil {
+		return nil, fmt.Errorf("could not count rows of database %s: %w", name, err)
+	}
+
+	counts := make(map[string]string, len(tables))
+	for i, result := range results {
+		if i >= len(tables) {
+			break
+		}
+		if result.Error != nil {
+			return nil, fmt.Errorf("could not count rows of table %s in database %s: %s", tables[i], name, result.Error.Message)
+		}
+		if result.Results != nil && len(result.Results.Rows) > 0 && len(result.Results.Rows[0]) > 0 {
+			counts[tables[i]] = fmt.Sprint(result.Results.Rows[0][0])
+		}
+	}
+	return counts, nil
+}
+
+func formatRowCount(count string, ok bool) string {
+	if !ok {
+		return "-"
+	}
+	return count
+}
@@ -0,0 +1,263 @@
+package cmd
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/tursodatabase/turso-cli/internal"
+	"github.com/tursodatabase/turso-cli/internal/prompt"
+)
+
+const exportPageSize = 1000
+
+var (
+	exportTableFlag  string
+	exportQueryFlag  string
+	exportFormatFlag string
+	exportOutFlag    string
+)
+
+func init() {
+	dbCmd.AddCommand(exportCmd)
+	exportCmd.Flags().StringVar(&exportTableFlag, "table", "", "Table to export.")
+	exportCmd.Flags().StringVar(&exportQueryFlag, "query", "", "SELECT statement whose results are exported, instead of a whole table.")
+	exportCmd.Flags().StringVar(&exportFormatFlag, "format", "csv", "Output format: csv or json.")
+	exportCmd.Flags().StringVar(&exportOutFlag, "out", "", "File to write to. Defaults to stdout.")
+	exportCmd.RegisterFlagCompletionFunc("format", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return []string{"csv", "json"}, cobra.ShellCompDirectiveNoFileComp
+	})
+	exportCmd.MarkFlagsMutuallyExclusive("table", "query")
+}
+
+var exportCmd = &cobra.Command{
+	Use:               "export <database-name> {--table <table> | --query <select>}",
+	Short:             "Export a table or the results of a query as CSV or JSON.",
+	Example:           "  turso db export name-of-my-amazing-db --table users --format csv --out users.csv\n  turso db export name-of-my-amazing-db --query \"select id, email from users\" --format json",
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: dbNameArg,
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+		if exportTableFlag == "" && exportQueryFlag == "" {
+			return fmt.Errorf("one of --table or --query must be specified")
+		}
+		if exportFormatFlag != "csv" && exportFormatFlag != "json" {
+			return fmt.Errorf("invalid format %s. Valid formats are csv and json", exportFormatFlag)
+		}
+		cmd.SilenceUsage = true
+
+		client, err := authedTursoClient()
+		if err != nil {
+			return err
+		}
+
+		db, err := databaseFromName(args[0], client)
+		if err != nil {
+			return err
+		}
+
+		token, err := tokenFromDb(db, client, nil)
+		if err != nil {
+			return err
+		}
+
+		var out io.Writer = os.Stdout
+		if exportOutFlag != "" {
+			path, err := resolveOutputPath(exportOutFlag)
+			if err != nil {
+				return err
+			}
+			f, err := os.Create(path)
+			if err != nil {
+				return fmt.Errorf("could not create output file: %w", err)
+			}
+			defer func() {
+				f.Close()
+				if err != nil {
+					os.Remove(path)
+				}
+			}()
+			out = f
+		}
+		w := bufio.NewWriter(out)
+
+		spinner := prompt.StoppedSpinner(fmt.Sprintf("Exporting from database %s...", internal.Emph(db.Name)))
+		if exportOutFlag != "" {
+			spinner.Start()
+		}
+		defer spinner.Stop()
+
+		rows, err := exportRows(w, getDatabaseHttpUrl(db), token)
+		if err != nil {
+			return err
+		}
+		if err = w.Flush(); err != nil {
+			return err
+		}
+
+		spinner.Stop()
+		if exportOutFlag != "" {
+			fmt.Printf("Exported %d rows to %s.\n", rows, internal.Emph(exportOutFlag))
+		}
+		return nil
+	},
+}
+
+func quoteIdentifier(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+// exportRows writes the rows of --table or the results of --query to w. A
+// query is run in a single request, since there is no way to page through
+// the results of an arbitrary query without skipping or repeating rows.
+func exportRows(w io.Writer, dbURL, token string) (int, error) {
+	writer := newRowWriter(w, exportFormatFlag)
+	total, started := 0, false
+	write := func(columns []string, rows []Row) error {
+		if !started {
+			if err := writer.header(columns); err != nil {
+				return err
+			}
+			started = true
+		}
+		for _, row := range rows {
+			if err := writer.row(row); err != nil {
+				return err
+			}
+		}
+		total += len(rows)
+		return nil
+	}
+
+	if exportTableFlag != "" {
+		if err := forEachTablePage(dbURL, token, exportTableFlag, write); err != nil {
+			return total, err
+		}
+		return total, writer.close()
+	}
+
+	query := strings.TrimSuffix(strings.TrimSpace(exportQueryFlag), ";")
+	rs, err := queryRows(dbURL, token, query)
+	if err != nil {
+		return total, err
+	}
+	if err := write(rs.Columns, rs.Rows); err != nil {
+		return total, err
+	}
+	return total, writer.close()
+}
+
+// forEachTablePage calls fn with the rows of table a page at a time, in rowid
+// order, so memory use doesn't grow with the size of the table. Each page
+// starts after the last rowid of the one before, so rows are never skipped
+// or repeated and later pages cost no more than the first. Tables declared
+// WITHOUT ROWID are fetched in a single request.
+func forEachTablePage(dbURL, token, table string, fn func(columns []string, rows []Row) error) error {
+	var last int64
+	for first := true; ; first = false {
+		page := fmt.Sprintf("SELECT _rowid_, * FROM %s WHERE _rowid_ > %d ORDER BY _rowid_ LIMIT %d", quoteIdentifier(table), last, exportPageSize)
+		rs, err := queryRows(dbURL, token, page)
+		if err != nil && first && strings.Contains(err.Error(), "no such column") {
+			rs, err = queryRows(dbURL, token, "SELECT * FROM "+quoteIdentifier(table))
+			if err != nil {
+				return err
+			}
+			return fn(rs.Columns, rs.Rows)
+		}
+		if err != nil {
+			return err
+		}
+		if len(rs.Columns) == 0 {
+			return fmt.Errorf("unexpected result without columns for table %s", table)
+		}
+
+		rows := make([]Row, 0, len(rs.Rows))
+		for _, row := range rs.Rows {
+			if len(row) == 0 {
+				return fmt.Errorf("unexpected empty row in table %s", table)
+			}
+			rowid, err := strconv.ParseInt(fmt.Sprint(row[0]), 10, 64)
+			if err != nil {
+				return fmt.Errorf("unexpected rowid %v in table %s", row[0], table)
+			}
+			last = rowid
+			rows = append(rows, row[1:])
+		}
+		if err := fn(rs.Columns[1:], rows); err != nil {
+			return err
+		}
+		if len(rs.Rows) < exportPageSize {
+			return nil
+		}
+	}
+}
+
+type rowWriter struct {
+	w       io.Writer
+	csv     *csv.Writer
+	columns []string
+	rows    int
+}
+
+func newRowWriter(w io.Writer, format string) *rowWriter {
+	rw := &rowWriter{w: w}
+	if format == "csv" {
+		rw.csv = csv.NewWriter(w)
+	}
+	return rw
+}
+
+func (rw *rowWriter) header(columns []string) error {
+	rw.columns = columns
+	if rw.csv != nil {
+		return rw.csv.Write(columns)
+	}
+	_, err := io.WriteString(rw.w, "[")
+	return err
+}
+
+func (rw *rowWriter) row(row Row) error {
+	if rw.csv != nil {
+		values := make([]string, 0, len(row))
+		for _, value := range row {
+			if value == nil {
+				values = append(values, "")
+				continue
+			}
+			values = append(values, fmt.Sprint(value))
+		}
+		return rw.csv.Write(values)
+	}
+
+	object := make(map[string]interface{}, len(rw.columns))
+	for i, column := range rw.columns {
+		if i < len(row) {
+			object[column] = row[i]
+		}
+	}
+	b, err := json.Marshal(object)
+	if err != nil {
+		return err
+	}
+	sep := ",\n"
+	if rw.rows == 0 {
+		sep = "\n"
+	}
+	rw.rows++
+	_, err = io.WriteString(rw.w, sep+string(b))
+	return err
+}
+
+func (rw *rowWriter) close() error {
+	if rw.csv != nil {
+		rw.csv.Flush()
+		return rw.csv.Error()
+	}
+	_, err := io.WriteString(rw.w, "\n]\n")
+	return err
+}
@@ -0,0 +1,100 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+)
+
+var tablePageQuery = regexp.MustCompile(`WHERE _rowid_ > (\d+) ORDER BY _rowid_ LIMIT (\d+)$`)
+
+// fakeTable serves a single-column table over the HTTP query API. Without
+// rowids it answers keyset queries the way a WITHOUT ROWID table does.
+func fakeTable(t *testing.T, rowids []int64, withoutRowid bool) (*httptest.Server, *int) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		var req QueryRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil || len(req.Statements) != 1 {
+			t.Errorf("unexpected request: %v", err)
+			return
+		}
+		statement := req.Statements[0]
+		match := tablePageQuery.FindStringSubmatch(statement)
+
+		var result QueryResult
+		switch {
+		case match != nil && withoutRowid:
+			result.Error = &Error{Message: "no such column: _rowid_"}
+		case match != nil:
+			var after int64
+			var limit int
+			fmt.Sscan(match[1], &after)
+			fmt.Sscan(match[2], &limit)
+			rs := &ResultSet{Columns: []string{"_rowid_", "id"}}
+			for _, rowid := range rowids {
+				if rowid > after && len(rs.Rows) < limit {
+					rs.Rows = append(rs.Rows, Row{json.Number(fmt.Sprint(rowid)), json.Number(fmt.Sprint(rowid * 10))})
+				}
+			}
+			result.Results = rs
+		case statement == `SELECT * FROM "items"`:
+			rs := &ResultSet{Columns: []string{"id"}}
+			for _, rowid := range rowids {
+				rs.Rows = append(rs.Rows, Row{json.Number(fmt.Sprint(rowid * 10))})
+			}
+			result.Results = rs
+		default:
+			t.Errorf("unexpected statement %q", statement)
+		}
+		json.NewEncoder(w).Encode([]QueryResult{result})
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+func TestForEachTablePage(t *testing.T) {
+	var rowids []int64
+	for rowid := int64(1); len(rowids) < 2*exportPageSize+5; rowid++ {
+		if rowid%7 != 0 {
+			rowids = append(rowids, rowid)
+		}
+	}
+
+	for _, withoutRowid := range []bool{false, true} {
+		t.Run(fmt.Sprintf("withoutRowid=%v", withoutRowid), func(t *testing.T) {
+			server, requests := fakeTable(t, rowids, withoutRowid)
+			var got []string
+			err := forEachTablePage(server.URL, "token", "items", func(columns []string, rows []Row) error {
+				if len(columns) != 1 || columns[0] != "id" {
+					t.Errorf("expected columns [id], got %v", columns)
+				}
+				for _, row := range rows {
+					got = append(got, fmt.Sprint(row...))
+				}
+				return nil
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(got) != len(rowids) {
+				t.Fatalf("expected %d rows, got %d", len(rowids), len(got))
+			}
+			for i, rowid := range rowids {
+				if want := fmt.Sprint(rowid * 10); got[i] != want {
+					t.Fatalf("row %d: expected %s, got %s", i, want, got[i])
+				}
+			}
+			want := 3
+			if withoutRowid {
+				want = 2
+			}
+			if *requests != want {
+				t.Errorf("expected %d requests, got %d", want, *requests)
+			}
+		})
+	}
+}
@@ -0,0 +1,244 @@
+package cmd
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/tursodatabase/turso-cli/internal"
+	"github.com/tursodatabase/turso-cli/internal/flags"
+	"github.com/tursodatabase/turso-cli/internal/prompt"
+)
+
+const importBatchSize = 500
+
+var (
+	importTableFlag       string
+	importFromFlag        string
+	importCreateTableFlag bool
+	importOnConflictFlag  string
+)
+
+func init() {
+	dbCmd.AddCommand(importCmd)
+	importCmd.Flags().StringVar(&importTableFlag, "table", "", "Table to insert the rows into.")
+	importCmd.Flags().StringVar(&importFromFlag, "from", "", "CSV file to import. The first line must be a header with the column names.")
+	importCmd.Flags().BoolVar(&importCreateTableFlag, "create-table", false, "Create the table if it doesn't exist, inferring column types from the data.")
+	importCmd.Flags().StringVar(&importOnConflictFlag, "on-conflict", "", "What to do with rows that violate a constraint: ignore or replace. By default the import fails.")
+	importCmd.RegisterFlagCompletionFunc("on-conflict", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return []string{"ignore", "replace"}, cobra.ShellCompDirectiveNoFileComp
+	})
+	flags.AddCSVSeparator(importCmd)
+	_ = importCmd.MarkFlagRequired("table")
+	_ = importCmd.MarkFlagRequired("from")
+}
+
+var importCmd = &cobra.Command{
+	Use:               "import <database-name> --table <table> --from <file.csv>",
+	Short:             "Insert the rows of a CSV file into a table.",
+	Example:           "  turso db import name-of-my-amazing-db --table users --from users.csv --create-table",
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: dbNameArg,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		insert, err := insertVerb(importOnConflictFlag)
+		if err != nil {
+			return err
+		}
+		separator, err := flags.CSVSeparator()
+		if err != nil {
+			return err
+		}
+		cmd.SilenceUsage = true
+
+		path, err := resolvePath(importFromFlag)
+		if err != nil {
+			return err
+		}
+		file, err := os.Open(path)
+		if err != nil {
+			return fmt.Errorf("could not open file %s: %w", importFromFlag, err)
+		}
+		defer file.Close()
+
+		reader := csv.NewReader(file)
+		reader.Comma = separator
+		header, err := reader.Read()
+		if err == io.EOF {
+			return fmt.Errorf("file %s is empty", importFromFlag)
+		}
+		if err != nil {
+			return fmt.Errorf("could not read header of %s: %w", importFromFlag, err)
+		}
+
+		client, err := authedTursoClient()
+		if err != nil {
+			return err
+		}
+
+		db, err := databaseFromName(args[0], client)
+		if err != nil {
+			return err
+		}
+
+		token, err := tokenFromDb(db, client, nil)
+		if err != nil {
+			return err
+		}
+		dbURL := getDatabaseHttpUrl(db)
+
+		spinner := prompt.Spinner(fmt.Sprintf("Importing %s into table %s...", internal.Emph(importFromFlag), internal.Emph(importTableFlag)))
+		defer spinner.Stop()
+
+		query := insertQuery(insert, importTableFlag, header)
+		count := 0
+		batch := make([]csvRecord, 0, importBatchSize)
+		flush := func() error {
+			if len(batch) == 0 {
+				return nil
+			}
+			if importCreateTableFlag && count == 0 {
+				if err := createTableFromCSV(dbURL, token, importTableFlag, header, batch); err != nil {
+					return err
+				}
+			}
+			if err := insertBatch(dbURL, token, query, batch); err != nil {
+				return err
+			}
+			count += len(batch)
+			batch = batch[:0]
+			spinner.Text(fmt.Sprintf("Importing %s into table %s... %d rows inserted", internal.Emph(importFromFlag), internal.Emph(importTableFlag), count))
+			return nil
+		}
+
+		for {
+			record, err := reader.Read()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return fmt.Errorf("could not read %s: %w", importFromFlag, err)
+			}
+			line, _ := reader.FieldPos(0)
+			batch = append(batch, csvRecord{values: record, line: line})
+			if len(batch) == importBatchSize {
+				if err := flush(); err != nil {
+					return err
+				}
+			}
+		}
+		if err := flush(); err != nil {
+			return err
+		}
+
+		spinner.Stop()
+		fmt.Printf("Inserted %d rows from %s into table %s of database %s.\n", count, internal.Emph(importFromFlag), internal.Emph(importTableFlag), internal.Emph(db.Name))
+		return nil
+	},
+}
+
+type csvRecord struct {
+	values []string
+	line   int
+}
+
+func insertVerb(onConflict string) (string, error) {
+	switch onConflict {
+	case "":
+		return "INSERT", nil
+	case "ignore":
+		return "INSERT OR IGNORE", nil
+	case "replace":
+		return "INSERT OR REPLACE", nil
+	default:
+		return "", fmt.Errorf("invalid --on-conflict value %s. Valid values are ignore and replace", onConflict)
+	}
+}
+
+func insertQuery(insert, table string, columns []string) string {
+	quoted := make([]string, 0, len(columns))
+	for _, column := range columns {
+		quoted = append(quoted, quoteIdentifier(column))
+	}
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(columns)), ", ")
+	return fmt.Sprintf("%s INTO %s (%s) VALUES (%s)", insert, quoteIdentifier(table), strings.Join(quoted, ", "), placeholders)
+}
+
+// insertBatch inserts records in a single transaction, so a failing batch
+// leaves no partial rows behind.
+func insertBatch(dbURL, token, query string, batch []csvRecord) error {
+	statements := make([]paramStatement, 0, len(batch)+2)
+	statements = append(statements, paramStatement{SQL: "BEGIN"})
+	for _, record := range batch {
+		params := make([]interface{}, 0, len(record.values))
+		for _, value := range record.values {
+			if value == "" {
+				params = append(params, nil)
+				continue
+			}
+			params = append(params, value)
+		}
+		statements = append(statements, paramStatement{SQL: query, Params: params})
+	}
+	statements = append(statements, paramStatement{SQL: "COMMIT"})
+
+	results, err := executeParamStatements(dbURL, token, statements)
+	if err != nil {
+		return fmt.Errorf("failed to insert rows starting at line %d: %w", batch[0].line, err)
+	}
+	for i, result := range results {
+		if result.Error == nil {
+			continue
+		}
+		if i >= 1 && i <= len(batch) {
+			return fmt.Errorf("failed to insert row at line %d: %s", batch[i-1].line, result.Error.Message)
+		}
+		return errors.New(result.Error.Message)
+	}
+	return nil
+}
+
+func createTableFromCSV(dbURL, token, table string, columns []string, sample []csvRecord) error {
+	definitions := make([]string, 0, len(columns))
+	for i, column := range columns {
+		definitions = append(definitions, quoteIdentifier(column)+" "+inferColumnType(sample, i))
+	}
+	statement := fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (%s)", quoteIdentifier(table), strings.Join(definitions, ", "))
+	if _, err := queryRows(dbURL, token, statement); err != nil {
+		return fmt.Errorf("could not create table %s: %w", table, err)
+	}
+	return nil
+}
+
+// inferColumnType returns INTEGER or REAL if every non-empty value of the
+// column in the sample is one, and TEXT otherwise.
+func inferColumnType(sample []csvRecord, column int) string {
+	isInteger, isReal, seen := true, true, false
+	for _, record := range sample {
+		if column >= len(record.values) || record.values[column] == "" {
+			continue
+		}
+		seen = true
+		value := record.values[column]
+		if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+			isInteger = false
+		}
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			isReal = false
+		}
+	}
+	switch {
+	case !seen:
+		return "TEXT"
+	case isInteger:
+		return "INTEGER"
+	case isReal:
+		return "REAL"
+	default:
+		return "TEXT"
+	}
+}
@@ -7,12 +7,12 @@ import (
 	"github.com/rodaine/table"
 	"github.com/spf13/cobra"
 	"github.com/tursodatabase/turso-cli/internal"
+	"github.com/tursodatabase/turso-cli/internal/flags"
 	"github.com/tursodatabase/turso-cli/internal/turso"
 )
 
 func init() {
 	dbCmd.AddCommand(dbInspectCmd)
-	addVerboseFlag(dbInspectCmd)
 	addQueriesFlag(dbInspectCmd)
 }
 
@@ -60,7 +60,7 @@ var dbInspectCmd = &cobra.Command{
 			return nil
 		}
 
-		if !verboseFlag {
+		if !flags.Verbose() {
 			return nil
 		}
 
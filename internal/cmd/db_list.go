@@ -1,23 +1,99 @@
 package cmd
 
 import (
-	"sort"
+	"fmt"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
 
 	"github.com/spf13/cobra"
+	"github.com/tursodatabase/turso-cli/internal"
+	"github.com/tursodatabase/turso-cli/internal/flags"
 	"github.com/tursodatabase/turso-cli/internal/turso"
 	"golang.org/x/exp/slices"
+	"golang.org/x/sync/errgroup"
+)
+
+var (
+	listUrlOnlyFlag  bool
+	listWithNameFlag bool
+	listFieldsFlag   string
+	withInstanceFlag bool
+	listWideFlag     bool
+	listCountFlag    bool
 )
 
 func init() {
 	dbCmd.AddCommand(listCmd)
+	listCmd.Flags().BoolVar(&listUrlOnlyFlag, "url-only", false, "Only print the connection URL of each database, one per line.")
+	listCmd.Flags().BoolVar(&listWithNameFlag, "with-name", false, "Print the database name next to its URL. Must be used with --url-only.")
+	listCmd.Flags().StringVar(&listFieldsFlag, "fields", "", "Comma-separated list of fields to include in JSON output, for example name,url.")
+	listCmd.Flags().BoolVar(&withInstanceFlag, "with-instances", false, "Include the instances of each database, with their URLs, in JSON output. This makes one extra request per database.")
+	listCmd.Flags().BoolVar(&listWideFlag, "wide", false, "Also show the ID, hostname, organization, primary location and description of each database. The primary is looked up with one extra request per database.")
+	listCmd.Flags().StringVar(&listColumnsFlag, "columns", "", "Comma-separated columns to show in table output, in order. Possible values: name, locations, primary, replicas, group, url, sleeping, id, host, org, description.")
+	listCmd.Flags().BoolVar(&listCountFlag, "count", false, "Only print the number of databases.")
+	listCmd.Flags().StringVar(&listSortFlag, "sort", "name", "Comma-separated fields to sort by, each optionally followed by :desc, for example replicas:desc,name.")
+	listCmd.Flags().BoolVar(&listDescFlag, "desc", false, "Reverse the sort order.")
+	listCmd.Flags().StringVar(&listFilterFlag, "filter", "", "Only list databases whose name matches a glob like 'prod-*', or contains the given text.")
+	listCmd.Flags().StringVar(&listRegexFlag, "regex", "", "Only list databases whose name matches a regular expression.")
+	addOutputFlag(listCmd)
+	addTemplateFlags(listCmd)
+	addPlainFlag(listCmd)
+	addNoHeaderFlag(listCmd)
+	listCmd.MarkFlagsMutuallyExclusive("plain", "output")
+	listCmd.MarkFlagsMutuallyExclusive("count", "url-only")
+	listCmd.MarkFlagsMutuallyExclusive("count", "wide")
+	listCmd.MarkFlagsMutuallyExclusive("columns", "wide")
+	listCmd.MarkFlagsMutuallyExclusive("columns", "url-only")
+	listCmd.MarkFlagsMutuallyExclusive("columns", "count")
+	listCmd.MarkFlagsMutuallyExclusive("filter", "regex")
 }
 
 var listCmd = &cobra.Command{
 	Use:               "list",
+	Aliases:           []string{"ls"},
 	Short:             "List databases.",
 	Args:              cobra.NoArgs,
 	ValidArgsFunction: noFilesArg,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if listWithNameFlag && !listUrlOnlyFlag {
+			return fmt.Errorf("--with-name can only be used with --url-only")
+		}
+		tmpl, err := parseOutputTemplate()
+		if err != nil {
+			return err
+		}
+		if listFieldsFlag != "" && !jsonOutput() {
+			return fmt.Errorf("--fields can only be used with --output json or ndjson")
+		}
+		if listCountFlag && (tmpl != nil || listFieldsFlag != "" || withInstanceFlag) {
+			return fmt.Errorf("--count can't be used with --output template, --fields or --with-instances")
+		}
+		if withInstanceFlag && !jsonOutput() {
+			return fmt.Errorf("--with-instances can only be used with --output json or ndjson")
+		}
+		if listColumnsFlag != "" && (jsonOutput() || tmpl != nil) {
+			return fmt.Errorf("--columns can only be used with table output")
+		}
+		headers, _ := dbListTable(nil, "", nil)
+		columns, err := parseListColumns(listColumnsFlag, headers)
+		if err != nil {
+			return err
+		}
+		fields, err := parseDatabaseFields(listFieldsFlag)
+		if err != nil {
+			return err
+		}
+		sortKeys, err := parseSortKeys(listSortFlag, listDescFlag)
+		if err != nil {
+			return err
+		}
+		match, err := parseNameFilter(listFilterFlag, listRegexFlag)
+		if err != nil {
+			return err
+		}
 		cmd.SilenceUsage = true
 		client, err := authedTursoClient()
 		if err != nil {
@@ -29,14 +105,175 @@ var listCmd = &cobra.Command{
 			return err
 		}
 		setDatabasesCache(databases)
+		databases = filterDatabases(databases, match)
+		sortDatabases(databases, sortKeys)
+
+		if listCountFlag {
+			if jsonOutput() {
+				return printJSON(struct {
+					Count int `json:"count"`
+				}{len(databases)})
+			}
+			fmt.Println(len(databases))
+			return nil
+		}
+
+		if listUrlOnlyFlag {
+			printDBListUrls(databases, listWithNameFlag)
+			return nil
+		}
+
+		if jsonOutput() {
+			org := ""
+			if len(fields) == 0 || slices.Contains(fields, "org") {
+				if org, err = organizationSlug(client); err != nil {
+					warnf("could not get the organization of the databases: %s", err)
+				}
+			}
+			return printDBListJSON(client, databases, fields, org)
+		}
+
+		if tmpl != nil {
+			records := make([]interface{}, 0, len(databases))
+			for _, database := range databases {
+				records = append(records, databaseTemplateData{Database: database, URL: getDatabaseUrl(&database)})
+			}
+			return executeTemplate(os.Stdout, tmpl, records...)
+		}
+
+		if len(databases) == 0 && !plainFlag {
+			printNoDatabases(match != nil)
+			return nil
+		}
 
-		printDBListTable(databases)
+		org := ""
+		if listWideFlag || slices.Contains(columns, "Org") {
+			if org, err = organizationSlug(client); err != nil {
+				return err
+			}
+		}
+		var primaries map[string]string
+		if listWideFlag || slices.Contains(columns, "Primary") {
+			primaries = primaryLocations(client, databases)
+		}
+		printDBListTable(databases, org, primaries, columns)
 		return nil
 	},
 }
 
-func printDBListTable(databases []turso.Database) {
-	headers, data := dbListTable(databases)
+func printNoDatabases(filtered bool) {
+	if filtered {
+		fmt.Println("No databases match the filter.")
+		return
+	}
+	fmt.Printf("No databases yet. Create one with %s\n", internal.Emph("turso db create"))
+}
+
+func printDBListUrls(databases []turso.Database, withName bool) {
+	for _, database := range databases {
+		if withName {
+			fmt.Printf("%s\t%s\n", database.Name, getDatabaseUrl(&database))
+			continue
+		}
+		fmt.Println(getDatabaseUrl(&database))
+	}
+}
+
+func printDBListJSON(client *turso.Client, databases []turso.Database, fields []string, org string) error {
+	infos := make([]databaseInfo, 0, len(databases))
+	for _, database := range databases {
+		info := newDatabaseInfo(database)
+		info.Org = org
+		infos = append(infos, info)
+	}
+	if withInstanceFlag {
+		if err := addInstances(client, databases, infos); err != nil {
+			return err
+		}
+	}
+	if len(fields) == 0 {
+		return printJSON(infos)
+	}
+
+	selected := make([]map[string]interface{}, 0, len(infos))
+	for _, info := range infos {
+		selected = append(selected, selectFields(info, fields))
+	}
+	return printJSON(selected)
+}
+
+// addInstances fills in the instances of each database, fetching them
+// concurrently since it takes one request per database.
+func addInstances(client *turso.Client, databases []turso.Database, infos []databaseInfo) error {
+	g := errgroup.Group{}
+	g.SetLimit(flags.MaxConcurrency())
+	for i := range databases {
+		i := i
+		g.Go(func() error {
+			instances, err := client.Instances.List(databases[i].Name)
+			if err != nil {
+				return fmt.Errorf("could not get instances of database %s: %w", databases[i].Name, err)
+			}
+			infos[i].Instances = newInstanceInfos(databases[i], instances)
+			return nil
+		})
+	}
+	return g.Wait()
+}
+
+// databaseFields returns the JSON field names of databaseInfo, in order.
+func databaseFields() []string {
+	t := reflect.TypeOf(databaseInfo{})
+	fields := make([]string, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		fields = append(fields, jsonFieldName(t.Field(i)))
+	}
+	return fields
+}
+
+func parseDatabaseFields(list string) ([]string, error) {
+	if list == "" {
+		return nil, nil
+	}
+	valid := databaseFields()
+	var fields []string
+	for _, field := range strings.Split(list, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		if !slices.Contains(valid, field) {
+			return nil, fmt.Errorf("unknown field %s. Valid fields are: %s", field, strings.Join(valid, ", "))
+		}
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+func selectFields(info databaseInfo, fields []string) map[string]interface{} {
+	v := reflect.ValueOf(info)
+	t := v.Type()
+	selected := make(map[string]interface{}, len(fields))
+	for i := 0; i < t.NumField(); i++ {
+		name := jsonFieldName(t.Field(i))
+		if slices.Contains(fields, name) {
+			selected[name] = v.Field(i).Interface()
+		}
+	}
+	return selected
+}
+
+func jsonFieldName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	return name
+}
+
+func printDBListTable(databases []turso.Database, org string, primaries map[string]string, columns []string) {
+	headers, data := dbListTable(databases, org, primaries)
+	if len(columns) > 0 {
+		printTable(selectColumns(headers, data, columns))
+		return
+	}
 	if !shouldPrintLocations(databases) {
 		headers, data = removeColumn(headers, data, "Locations")
 	}
@@ -47,6 +284,15 @@ func printDBListTable(databases []turso.Database) {
 	if !shouldPrintSleeping(databases) {
 		headers, data = removeColumn(headers, data, "Sleeping")
 	}
+	if !shouldPrintDescriptions(databases) {
+		headers, data = removeColumn(headers, data, "Description")
+	}
+	if !listWideFlag {
+		headers, data = removeColumn(headers, data, "ID")
+		headers, data = removeColumn(headers, data, "Host")
+		headers, data = removeColumn(headers, data, "Org")
+		headers, data = removeColumn(headers, data, "Primary")
+	}
 
 	printTable(headers, data)
 }
@@ -77,17 +323,76 @@ func shouldPrintSleeping(databases []turso.Database) bool {
 	return false
 }
 
-func dbListTable(databases []turso.Database) (headers []string, data [][]string) {
+func shouldPrintDescriptions(databases []turso.Database) bool {
+	if !listWideFlag {
+		return false
+	}
+	for _, database := range databases {
+		if databaseDescription(database.ID) != "" {
+			return true
+		}
+	}
+	return false
+}
+
+func dbListTable(databases []turso.Database, org string, primaries map[string]string) (headers []string, data [][]string) {
 	for _, database := range databases {
-		row := []string{database.Name, getDatabaseLocations(database), formatGroup(database.Group), getDatabaseUrl(&database), formatBool(database.Sleeping)}
+		primary, ok := primaries[database.Name]
+		if !ok {
+			primary = database.PrimaryRegion
+		}
+		row := []string{database.Name, getDatabaseLocations(database), primary, strconv.Itoa(len(database.Regions)), formatGroup(database.Group), getDatabaseUrl(&database), formatBool(database.Sleeping), database.ID, database.Hostname, org, databaseDescription(database.ID)}
 		data = append(data, row)
 	}
 
-	sort.Slice(data, func(i, j int) bool {
-		return data[i][0] < data[j][0]
-	})
+	return []string{"Name", "Locations", "Primary", "Replicas", "Group", "URL", "Sleeping", "ID", "Host", "Org", "Description"}, data
+}
 
-	return []string{"Name", "Locations", "Group", "URL", "Sleeping"}, data
+// primaryLocations returns the location of the primary instance of each
+// database, by name. Databases whose instances can't be fetched are left out,
+// so that the primary location reported in the database is used instead.
+func primaryLocations(client *turso.Client, databases []turso.Database) map[string]string {
+	var mu sync.Mutex
+	primaries := make(map[string]string, len(databases))
+	g := errgroup.Group{}
+	g.SetLimit(flags.MaxConcurrency())
+	for _, database := range databases {
+		name := database.Name
+		g.Go(func() error {
+			instances, err := client.Instances.List(name)
+			if err != nil {
+				return nil
+			}
+			primary, _ := extractPrimary(instances)
+			if primary == nil {
+				return nil
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			primaries[name] = primary.Region
+			return nil
+		})
+	}
+	_ = g.Wait()
+	return primaries
+}
+
+// organizationSlug returns the slug of the organization the client works on,
+// looking up the personal one when no organization is selected.
+func organizationSlug(client *turso.Client) (string, error) {
+	if client.Org != "" {
+		return client.Org, nil
+	}
+	orgs, err := client.Organizations.List()
+	if err != nil {
+		return "", err
+	}
+	for _, org := range orgs {
+		if org.Type == "personal" {
+			return org.Slug, nil
+		}
+	}
+	return "", nil
 }
 
 func removeColumn(headers []string, data [][]string, column string) ([]string, [][]string) {
@@ -1,19 +1,31 @@
 package cmd
 
 import (
+	"bytes"
 	"fmt"
+	"io"
 	"math"
+	"os"
 	"sort"
+	"strings"
 
+	"github.com/rodaine/table"
 	"github.com/spf13/cobra"
 	"github.com/tursodatabase/turso-cli/internal"
 	"github.com/tursodatabase/turso-cli/internal/turso"
 	"golang.org/x/exp/maps"
 )
 
+var nearestFlag int
+
 func init() {
 	dbCmd.AddCommand(regionsCmd)
 	addLatencyFlag(regionsCmd)
+	addOutputFlag(regionsCmd)
+	addPlainFlag(regionsCmd)
+	addNoHeaderFlag(regionsCmd)
+	regionsCmd.Flags().IntVar(&nearestFlag, "nearest", 0, "Only list the N locations with the lowest latency from your current location, closest first. Implies --show-latencies.")
+	regionsCmd.MarkFlagsMutuallyExclusive("plain", "output")
 }
 
 var regionsCmd = &cobra.Command{
@@ -22,6 +34,15 @@ var regionsCmd = &cobra.Command{
 	Args:              cobra.NoArgs,
 	ValidArgsFunction: noFilesArg,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := validateOutputFlag(); err != nil {
+			return err
+		}
+		if cmd.Flags().Changed("nearest") {
+			if nearestFlag < 1 {
+				return fmt.Errorf("--nearest must be at least 1")
+			}
+			latencyFlag = true
+		}
 		cmd.SilenceUsage = true
 		client, err := authedTursoClient()
 		if err != nil {
@@ -48,8 +69,14 @@ var regionsCmd = &cobra.Command{
 			}
 			ids = maps.Keys(lats)
 			sort.Slice(ids, func(i, j int) bool {
+				if lats[ids[i]] == lats[ids[j]] {
+					return ids[i] < ids[j]
+				}
 				return lats[ids[i]] < lats[ids[j]]
 			})
+			if nearestFlag > 0 {
+				ids = nearestLocations(ids, lats, nearestFlag)
+			}
 			columns = append(columns, "ID")
 			columns = append(columns, "LOCATION")
 			columns = append(columns, "LATENCY↓")
@@ -60,6 +87,15 @@ var regionsCmd = &cobra.Command{
 			columns = append(columns, "LOCATION")
 		}
 
+		if jsonOutput() {
+			return printLocationsJSON(ids, locations, closest, lats)
+		}
+
+		if plainFlag {
+			printLocationsPlain(ids, locations, closest, lats)
+			return nil
+		}
+
 		tbl := turso.LocationsTable(columns)
 
 		for _, location := range ids {
@@ -87,7 +123,101 @@ var regionsCmd = &cobra.Command{
 				}
 			}
 		}
+		if noHeaderFlag {
+			printTableRows(os.Stdout, tbl)
+			return nil
+		}
 		tbl.Print()
 		return nil
 	},
 }
+
+// printTableRows prints tbl to w without its header row. The table always
+// prints its header, so it is printed to a buffer and the first line is
+// dropped, which keeps the column widths.
+func printTableRows(w io.Writer, tbl table.Table) {
+	var buf bytes.Buffer
+	tbl.WithWriter(&buf).Print()
+	_, rows, _ := strings.Cut(buf.String(), "\n")
+	fmt.Fprint(w, rows)
+}
+
+// nearestLocations returns the first n of ids, sorted by latency, skipping
+// locations that couldn't be reached.
+func nearestLocations(ids []string, lats map[string]int, n int) []string {
+	nearest := make([]string, 0, n)
+	for _, id := range ids {
+		if len(nearest) == n {
+			break
+		}
+		if lats[id] != math.MaxInt {
+			nearest = append(nearest, id)
+		}
+	}
+	return nearest
+}
+
+func printLocationsPlain(ids []string, locations map[string]string, closest string, lats map[string]int) {
+	headers := []string{"ID", "LOCATION", "DEFAULT"}
+	if latencyFlag {
+		headers = append(headers, "LATENCY")
+	}
+	data := make([][]string, 0, len(ids))
+	for _, id := range ids {
+		row := []string{id, locations[id], formatBool(id == closest)}
+		if latencyFlag {
+			latency := "???"
+			if lat, ok := lats[id]; ok && lat != math.MaxInt {
+				latency = fmt.Sprintf("%dms", lat)
+			}
+			row = append(row, latency)
+		}
+		data = append(data, row)
+	}
+	printTable(headers, data)
+}
+
+type locationInfo struct {
+	ID        string `json:"id"`
+	Location  string `json:"location"`
+	Country   string `json:"country,omitempty"`
+	Continent string `json:"continent,omitempty"`
+	Default   bool   `json:"default"`
+}
+
+func newLocationInfo(id, description, closest string) locationInfo {
+	country := locationCountry(description)
+	return locationInfo{
+		ID:        id,
+		Location:  description,
+		Country:   country,
+		Continent: locationContinent(country),
+		Default:   id == closest,
+	}
+}
+
+type locationLatencyInfo struct {
+	locationInfo
+	LatencyMs *int `json:"latency_ms"`
+}
+
+func printLocationsJSON(ids []string, locations map[string]string, closest string, lats map[string]int) error {
+	if !latencyFlag {
+		result := make([]locationInfo, 0, len(ids))
+		for _, id := range ids {
+			result = append(result, newLocationInfo(id, locations[id], closest))
+		}
+		return printJSON(result)
+	}
+
+	result := make([]locationLatencyInfo, 0, len(ids))
+	for _, id := range ids {
+		info := locationLatencyInfo{locationInfo: newLocationInfo(id, locations[id], closest)}
+		if lat, ok := lats[id]; ok && lat != math.MaxInt {
+			lat := lat
+			info.LatencyMs = &lat
+		}
+		result = append(result, info)
+	}
+	return printJSON(result)
+}
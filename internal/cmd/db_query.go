@@ -0,0 +1,108 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// paramStatement is a statement with positional parameters bound to its ?
+// placeholders.
+type paramStatement struct {
+	SQL    string        `json:"q"`
+	Params []interface{} `json:"params,omitempty"`
+}
+
+// executeStatements runs statements against the HTTP API of the database in a
+// single request, returning one result per statement.
+func executeStatements(dbURL, token string, statements []string) ([]QueryResult, error) {
+	return postStatements(dbURL, token, QueryRequest{Statements: statements})
+}
+
+// executeParamStatements is like executeStatements, for statements with
+// parameters.
+func executeParamStatements(dbURL, token string, statements []paramStatement) ([]QueryResult, error) {
+	return postStatements(dbURL, token, struct {
+		Statements []paramStatement `json:"statements"`
+	}{statements})
+}
+
+func postStatements(dbURL, token string, request interface{}) ([]QueryResult, error) {
+	resp, err := sendStatements(dbURL, token, request)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var results []QueryResult
+	decoder := json.NewDecoder(resp.Body)
+	decoder.UseNumber()
+	if err := decoder.Decode(&results); err != nil {
+		return nil, fmt.Errorf("failed to deserialize query response: %w", err)
+	}
+	return results, nil
+}
+
+// sendStatements posts request to the HTTP API of the database, returning the
+// response for the caller to decode and close when it succeeded.
+func sendStatements(dbURL, token string, request interface{}) (*http.Response, error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("could not serialize request body: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(commandContext(), "POST", dbURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Authorization", "Bearer "+token)
+	req.Header.Add("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		var errResp ErrorResponse
+		if err := json.NewDecoder(resp.Body).Decode(&errResp); err == nil && errResp.Message != "" {
+			return nil, fmt.Errorf("%s", errResp.Message)
+		}
+		return nil, fmt.Errorf("query failed with status %s", resp.Status)
+	}
+	return resp, nil
+}
+
+// queryRows runs a single statement and returns its result set.
+func queryRows(dbURL, token, statement string) (*ResultSet, error) {
+	results, err := executeStatements(dbURL, token, []string{statement})
+	if err != nil {
+		return nil, err
+	}
+	if len(results) != 1 {
+		return nil, fmt.Errorf("expected 1 result, got %d", len(results))
+	}
+	if results[0].Error != nil {
+		return nil, fmt.Errorf("%s", results[0].Error.Message)
+	}
+	if results[0].Results == nil {
+		return &ResultSet{}, nil
+	}
+	return results[0].Results, nil
+}
+
+func listTables(dbURL, token string) ([]string, error) {
+	rs, err := queryRows(dbURL, token, "SELECT name FROM sqlite_master WHERE type = 'table' AND name NOT LIKE 'sqlite_%' AND name NOT LIKE '_litestream_%' AND name NOT LIKE 'libsql_%' ORDER BY name")
+	if err != nil {
+		return nil, err
+	}
+	tables := make([]string, 0, len(rs.Rows))
+	for _, row := range rs.Rows {
+		if len(row) > 0 {
+			tables = append(tables, fmt.Sprint(row[0]))
+		}
+	}
+	return tables, nil
+}
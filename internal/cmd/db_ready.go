@@ -0,0 +1,30 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// errTimeout marks errors caused by giving up waiting for a resource, so that
+// the CLI can exit with exitCodeTimeout.
+var errTimeout = errors.New("timed out")
+
+// waitUntilReady polls the database at dbURL until it answers queries or ctx
+// is done. It returns the last error seen while polling, if any.
+func waitUntilReady(ctx context.Context, dbURL, token string) (string, error) {
+	defer timePhase("wait")()
+	var last string
+	err := pollUntil(ctx, func() (bool, error) {
+		_, err := queryRows(dbURL, token, "SELECT 1")
+		if err != nil {
+			last = err.Error()
+			return false, nil
+		}
+		return true, nil
+	})
+	if err != nil {
+		return last, fmt.Errorf("%w: %w", errTimeout, err)
+	}
+	return "", nil
+}
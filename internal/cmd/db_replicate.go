@@ -1,28 +1,49 @@
 package cmd
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"sort"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/manifoldco/promptui"
 	"github.com/rodaine/table"
 	"github.com/spf13/cobra"
 	"github.com/tursodatabase/turso-cli/internal"
+	"github.com/tursodatabase/turso-cli/internal/flags"
 	"github.com/tursodatabase/turso-cli/internal/prompt"
+	"github.com/tursodatabase/turso-cli/internal/settings"
 	"github.com/tursodatabase/turso-cli/internal/turso"
 	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+	"golang.org/x/sync/errgroup"
 )
 
 func init() {
 	dbCmd.AddCommand(replicateCmd)
 	addCanaryFlag(replicateCmd)
 	addWaitFlag(replicateCmd, "Wait for the replica to be ready to receive requests.")
+	addForceFlag(replicateCmd, "Recreate the replica if the database already has one in the selected location.")
+	replicateCmd.Flags().BoolVar(&allLocationsFlag, "all-locations", false, "Replicate the database to every location it is not in yet.")
+	replicateCmd.Flags().IntVar(&parallelFlag, "parallel", 0, "Number of replicas to create concurrently when using --all-locations or --location-group. Defaults to --max-concurrency.")
+	addLocationGroupFlag(replicateCmd, "Replicate the database to every location of these comma-separated location groups it is not in yet.")
+	replicateCmd.Flags().DurationVar(&replicateTimeoutFlag, "timeout", 10*time.Minute, "Maximum time to wait for the replicas to be ready with --wait.")
+	replicateCmd.MarkFlagsMutuallyExclusive("all-locations", "location-group")
+	addReplicateClosestFlag(replicateCmd)
 }
 
+var (
+	allLocationsFlag     bool
+	parallelFlag         int
+	replicateTimeoutFlag time.Duration
+)
+
 var replicateCmd = &cobra.Command{
 	Use:               "replicate <database-name> <location-code>",
+	Aliases:           []string{"repl"},
 	Short:             "Replicate a database.",
 	Args:              cobra.RangeArgs(1, 2),
 	ValidArgsFunction: replicateArgs,
@@ -36,11 +57,46 @@ var replicateCmd = &cobra.Command{
 			return fmt.Errorf("you must specify a database name to replicate it")
 		}
 
+		image, err := imageFromFlags()
+		if err != nil {
+			return err
+		}
+
 		database, err := getDatabase(client, dbName, true)
 		if err != nil {
 			return err
 		}
 
+		if allLocationsFlag || locationGroupFlag != "" {
+			if len(args) > 1 {
+				return fmt.Errorf("can not specify a location when using %s or %s", internal.Emph("--all-locations"), internal.Emph("--location-group"))
+			}
+			parallel := flags.MaxConcurrency()
+			if cmd.Flags().Changed("parallel") {
+				if parallelFlag < 1 {
+					return fmt.Errorf("--parallel must be at least 1")
+				}
+				parallel = parallelFlag
+			}
+			cmd.SilenceUsage = true
+			if ok, _ := canReplicate(client, dbName); !ok {
+				return fmt.Errorf("database %s is part of a group.\nUse %s to replicate the group instead", internal.Emph(dbName), internal.Emph("turso group locations add"))
+			}
+			if allLocationsFlag {
+				return replicateToAllLocations(client, database, image, parallel)
+			}
+			locations, err := locationGroupLocations(client, locationGroupFlag)
+			if err != nil {
+				return err
+			}
+			targets := missingLocations(database, locations)
+			if len(targets) == 0 {
+				fmt.Printf("Database %s is already replicated to every location of %s.\n", internal.Emph(database.Name), internal.Emph(locationGroupFlag))
+				return nil
+			}
+			return replicateToLocations(client, database, targets, image, parallel)
+		}
+
 		location, err := getReplicateLocation(client, args, database)
 		if err != nil {
 			return err
@@ -55,11 +111,29 @@ var replicateCmd = &cobra.Command{
 			return fmt.Errorf("database %s is part of a group.\nUse %s to replicate the group instead", internal.Emph(dbName), cmd)
 		}
 
-		instance, err := replicate(client, database, location)
+		instance, err := existingReplica(client, database, location)
 		if err != nil {
 			return err
 		}
 
+		if instance != nil && forceFlag {
+			if err := destroyDatabaseInstance(client, dbName, instance.Name); err != nil {
+				return err
+			}
+			instance = nil
+		}
+
+		if instance != nil {
+			fmt.Printf("Database %s already has a replica at %s. Use %s to recreate it.\n\n", internal.Emph(dbName), internal.Emph(formatLocation(client, location)), internal.Emph("--force"))
+		} else {
+			instance, err = replicate(client, database, location, image)
+			if err != nil {
+				return err
+			}
+		}
+
+		printTopology(client, dbName)
+
 		showCmd := fmt.Sprintf("turso db show %s", dbName)
 		urlCmd := fmt.Sprintf("turso db show %s --instance-url %s", dbName, instance.Name)
 		fmt.Printf("To see information about the database %s, run:\n\n\t%s\n\n", internal.Emph(dbName), internal.Emph(showCmd))
@@ -70,23 +144,28 @@ var replicateCmd = &cobra.Command{
 	},
 }
 
-func replicate(client *turso.Client, database turso.Database, location string) (*turso.Instance, error) {
+func replicate(client *turso.Client, database turso.Database, location, image string) (*turso.Instance, error) {
 	start := time.Now()
-	instance, err := createInstance(client, database, location)
+	instance, err := createInstance(client, database, location, image)
 	if shouldRetryReplicate(err) {
 		location, err = selectAlternativeLocation(client, database.Name, location)
 		if err != nil {
 			return nil, err
 		}
 		start = time.Now()
-		instance, err = createInstance(client, database, location)
+		instance, err = createInstance(client, database, location, image)
 	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to replicate database: %s", err)
 	}
 
+	// The replica exists from now on, so make sure an interrupted wait
+	// doesn't leave the local cache pointing to the old topology.
+	invalidateDatabasesCache()
+	settings.PersistChanges()
+
 	if waitFlag {
-		err := waitForInstance(client, database.Name, instance.Name, location)
+		err := waitForInstance(client, database, *instance)
 		if err != nil {
 			return nil, err
 		}
@@ -97,11 +176,195 @@ func replicate(client *turso.Client, database turso.Database, location string) (
 	return instance, nil
 }
 
-func waitForInstance(client *turso.Client, database, instance, location string) error {
-	description := fmt.Sprintf("Waiting for replica of %s at %s to be ready", internal.Emph(database), internal.Emph(formatLocation(client, location)))
+// existingReplica returns the replica instance of database at location, or nil
+// if the database is not replicated there yet.
+func existingReplica(client *turso.Client, database turso.Database, location string) (*turso.Instance, error) {
+	if !slices.Contains(database.Regions, location) {
+		return nil, nil
+	}
+
+	instances, err := client.Instances.List(database.Name)
+	if err != nil {
+		return nil, fmt.Errorf("could not get instances of database %s: %w", database.Name, err)
+	}
+
+	primary, replicas := extractPrimary(filterInstancesByRegion(instances, location))
+	if len(replicas) > 0 {
+		return &replicas[0], nil
+	}
+	if primary != nil {
+		return nil, fmt.Errorf("location %s already hosts the primary of database %s", internal.Emph(location), internal.Emph(database.Name))
+	}
+	return nil, nil
+}
+
+type replicaResult struct {
+	location string
+	instance string
+	err      error
+}
+
+func replicateToAllLocations(client *turso.Client, database turso.Database, image string, parallel int) error {
+	all, err := locations(client)
+	if err != nil {
+		return err
+	}
+
+	targets := missingLocations(database, maps.Keys(all))
+	if len(targets) == 0 {
+		fmt.Printf("Database %s is already replicated to every location.\n", internal.Emph(database.Name))
+		return nil
+	}
+	return replicateToLocations(client, database, targets, image, parallel)
+}
+
+// missingLocations returns the locations that database is not in yet.
+func missingLocations(database turso.Database, locations []string) []string {
+	missing := []string{}
+	for _, location := range locations {
+		if !slices.Contains(database.Regions, location) {
+			missing = append(missing, location)
+		}
+	}
+	return missing
+}
+
+// replicateToLocations creates replicas of database in targets concurrently,
+// printing a table with the outcome for each location.
+func replicateToLocations(client *turso.Client, database turso.Database, targets []string, image string, parallel int) error {
+	start := time.Now()
+	s := prompt.Spinner(fmt.Sprintf("Replicating database %s to %d locations...", internal.Emph(database.Name), len(targets)))
+	defer s.Stop()
+
+	var token string
+	if waitFlag {
+		var err error
+		if token, err = tokenFromDb(&database, client, nil); err != nil {
+			return err
+		}
+	}
+	ctx, cancel := context.WithTimeout(commandContext(), replicateTimeoutFlag)
+	defer cancel()
+
+	var mu sync.Mutex
+	results := make([]replicaResult, 0, len(targets))
+	g := errgroup.Group{}
+	g.SetLimit(parallel)
+	for _, location := range targets {
+		location := location
+		g.Go(func() error {
+			result := replicaResult{location: location}
+			instance, err := createReplica(client, database, location, image)
+			if err == nil && waitFlag {
+				err = waitForReplica(ctx, client, database, *instance, token)
+			}
+			if instance != nil {
+				result.instance = instance.Name
+			}
+			result.err = err
+
+			mu.Lock()
+			defer mu.Unlock()
+			results = append(results, result)
+			s.Text(fmt.Sprintf("Replicating database %s to %d locations... %d/%d done", internal.Emph(database.Name), len(targets), len(results), len(targets)))
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	invalidateDatabasesCache()
+	settings.PersistChanges()
+	s.Stop()
+
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].location < results[j].location
+	})
+
+	failed := 0
+	data := make([][]string, 0, len(results))
+	for _, result := range results {
+		status := "replicated"
+		if result.err != nil {
+			status = result.err.Error()
+			failed++
+		}
+		data = append(data, []string{result.location, result.instance, status})
+	}
+	printTable([]string{"Location", "Instance", "Status"}, data)
+
+	elapsed := time.Since(start)
+	fmt.Printf("\nReplicated database %s to %d of %d locations in %d seconds.\n", internal.Emph(database.Name), len(results)-failed, len(results), int(elapsed.Seconds()))
+	if failed < len(results) {
+		printTopology(client, database.Name)
+	}
+	if failed > 0 {
+		return fmt.Errorf("failed to replicate database %s to %d locations", database.Name, failed)
+	}
+	return nil
+}
+
+// printTopology prints the locations a database spans after its replicas
+// changed, as reported by the API rather than assumed from the change.
+func printTopology(client *turso.Client, name string) {
+	if jsonOutput() || flags.Quiet() {
+		return
+	}
+	database, err := getDatabase(client, name, true)
+	if err != nil || len(database.Regions) < 2 {
+		return
+	}
+	locations := slices.Clone(database.Regions)
+	sort.Strings(locations)
+	fmt.Printf("Database %s now spans %d locations: %s.\n\n", internal.Emph(name), len(locations), strings.Join(locations, ", "))
+}
+
+func createReplica(client *turso.Client, database turso.Database, location, image string) (*turso.Instance, error) {
+	defer timePhase("create")()
+	if database.Group != "" {
+		return &turso.Instance{Name: location, Region: location}, client.Groups.AddLocation(database.Group, location)
+	}
+	return client.Instances.Create(database.Name, location, image)
+}
+
+func waitForInstance(client *turso.Client, database turso.Database, instance turso.Instance) error {
+	token, err := tokenFromDb(&database, client, nil)
+	if err != nil {
+		return err
+	}
+	description := fmt.Sprintf("Waiting for replica of %s at %s to be ready", internal.Emph(database.Name), internal.Emph(formatLocation(client, instance.Region)))
 	s := prompt.Spinner(description)
 	defer s.Stop()
-	return client.Instances.Wait(database, instance)
+
+	ctx, cancel := context.WithTimeout(commandContext(), replicateTimeoutFlag)
+	defer cancel()
+	return waitForReplica(ctx, client, database, instance, token)
+}
+
+// waitForReplica polls the replica instance of database until it answers
+// queries or ctx is done.
+func waitForReplica(ctx context.Context, client *turso.Client, database turso.Database, instance turso.Instance, token string) error {
+	defer timePhase("wait")()
+	last := "the replica is not listed yet"
+	err := pollUntil(ctx, func() (bool, error) {
+		instances, err := client.Instances.List(database.Name)
+		if err != nil {
+			last = err.Error()
+			return false, nil
+		}
+		idx := slices.IndexFunc(instances, func(i turso.Instance) bool { return i.Name == instance.Name })
+		if idx == -1 {
+			return false, nil
+		}
+		if _, err := queryRows(getUrl(&database, &instances[idx], "https"), token, "SELECT 1"); err != nil {
+			last = err.Error()
+			return false, nil
+		}
+		return true, nil
+	})
+	if err != nil {
+		return fmt.Errorf("replica %s of %s at %s was not ready after %s: %w: %w\nLast error: %s", instance.Name, database.Name, instance.Region, replicateTimeoutFlag, errTimeout, err, last)
+	}
+	return nil
 }
 
 func shouldRetryReplicate(err error) bool {
@@ -134,31 +397,33 @@ func selectAlternativeLocation(client *turso.Client, database, locationID string
 	return locationID, nil
 }
 
-func createInstance(client *turso.Client, database turso.Database, location string) (*turso.Instance, error) {
+func createInstance(client *turso.Client, database turso.Database, location, image string) (*turso.Instance, error) {
 	description := fmt.Sprintf("Replicating database %s to %s", internal.Emph(database.Name), internal.Emph(formatLocation(client, location)))
 	s := prompt.Spinner(description)
 	defer s.Stop()
 
-	if database.Group != "" {
-		return &turso.Instance{Name: location, Region: location}, client.Groups.AddLocation(database.Group, location)
-	}
-
-	return client.Instances.Create(database.Name, location)
+	return createReplica(client, database, location, image)
 }
 
 func replicateArgs(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
-	client, err := authedTursoClient()
-	if err != nil {
-		return nil, cobra.ShellCompDirectiveNoFileComp | cobra.ShellCompDirectiveNoSpace
-	}
 	if len(args) == 1 {
-		locations, _ := locations(client)
-		return maps.Keys(locations), cobra.ShellCompDirectiveNoFileComp | cobra.ShellCompDirectiveNoSpace
+		return completeLocationIDs(), cobra.ShellCompDirectiveNoFileComp | cobra.ShellCompDirectiveNoSpace
 	}
 	return dbNameArg(cmd, args, toComplete)
 }
 
 func getReplicateLocation(client *turso.Client, args []string, database turso.Database) (string, error) {
+	if replicateClosestFlag {
+		if len(args) > 1 {
+			return "", fmt.Errorf("can not specify a location when using %s", internal.Emph("--closest"))
+		}
+		location, err := closestReplicaLocation(client, database)
+		if err != nil {
+			return "", err
+		}
+		fmt.Printf("Closest location without a replica of %s is %s.\n", internal.Emph(database.Name), internal.Emph(formatLocation(client, location)))
+		return location, nil
+	}
 	if len(args) > 1 {
 		return args[1], nil
 	}
@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/spf13/cobra"
+	"github.com/tursodatabase/turso-cli/internal"
+	"github.com/tursodatabase/turso-cli/internal/prompt"
+	"github.com/tursodatabase/turso-cli/internal/turso"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+)
+
+var replicateClosestFlag bool
+
+func addReplicateClosestFlag(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&replicateClosestFlag, "closest", false, "Replicate the database to the location closest to you that it is not in yet.")
+	cmd.MarkFlagsMutuallyExclusive("closest", "all-locations")
+	cmd.MarkFlagsMutuallyExclusive("closest", "location-group")
+}
+
+// closestReplicaLocation returns the location closest to the client that
+// database is not in yet. When the closest location already hosts the
+// database, the other locations are probed to find the next closest one.
+func closestReplicaLocation(client *turso.Client, database turso.Database) (string, error) {
+	closest, err := closestLocation(client)
+	if err == nil && !slices.Contains(database.Regions, closest) {
+		return closest, nil
+	}
+
+	s := prompt.Spinner("Measuring the latency to each location...")
+	measured, err := latencies(client)
+	s.Stop()
+	if err != nil {
+		return "", fmt.Errorf("could not measure the latency to each location: %w", err)
+	}
+	location := closestMissingLocation(database, measured)
+	if location == "" {
+		return "", fmt.Errorf("could not find a reachable location that database %s is not in yet", internal.Emph(database.Name))
+	}
+	return location, nil
+}
+
+// closestMissingLocation returns the location with the lowest latency that
+// database is not in yet, or an empty string if none could be reached.
+func closestMissingLocation(database turso.Database, latencies map[string]int) string {
+	candidates := missingLocations(database, maps.Keys(latencies))
+	sort.Strings(candidates)
+	best, lowest := "", math.MaxInt
+	for _, location := range candidates {
+		if latency := latencies[location]; latency < lowest {
+			best, lowest = location, latency
+		}
+	}
+	return best
+}
@@ -0,0 +1,26 @@
+package cmd
+
+import (
+	"math"
+	"testing"
+
+	"github.com/tursodatabase/turso-cli/internal/turso"
+)
+
+func TestClosestMissingLocation(t *testing.T) {
+	database := turso.Database{Name: "db", Regions: []string{"ams", "fra"}}
+	tests := []struct {
+		latencies map[string]int
+		expected  string
+	}{
+		{latencies: map[string]int{"ams": 5, "fra": 10, "lhr": 20, "gru": 200}, expected: "lhr"},
+		{latencies: map[string]int{"ams": 5, "lhr": 20, "cdg": 20}, expected: "cdg"},
+		{latencies: map[string]int{"ams": 5, "gru": math.MaxInt}, expected: ""},
+		{latencies: map[string]int{"ams": 5, "fra": 10}, expected: ""},
+	}
+	for _, tt := range tests {
+		if got := closestMissingLocation(database, tt.latencies); got != tt.expected {
+			t.Errorf("latencies %v: expected %q, got %q", tt.latencies, tt.expected, got)
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/tursodatabase/turso-cli/internal"
+	"github.com/tursodatabase/turso-cli/internal/prompt"
+)
+
+const restoreBatchSize = 200
+
+var restoreFromFlag string
+
+// Dumps wrap everything in a transaction, which can't span HTTP requests.
+var dumpTransactionRegex = regexp.MustCompile(`(?i)^(BEGIN( TRANSACTION)?|COMMIT|END( TRANSACTION)?)$`)
+
+func init() {
+	dbCmd.AddCommand(restoreCmd)
+	restoreCmd.Flags().StringVar(&restoreFromFlag, "from", "", "Path to the SQL dump file to restore.")
+	addYesFlag(restoreCmd, "Confirms restoring into a database that already has tables.")
+}
+
+var restoreCmd = &cobra.Command{
+	Use:               "restore <database-name> --from <dump-file>",
+	Short:             "Restore a SQL dump into an existing database.",
+	Example:           "  turso db restore name-of-my-amazing-db --from backup.sql",
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: dbNameArg,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if restoreFromFlag == "" {
+			return fmt.Errorf("you must specify a dump file with %s", internal.Emph("--from"))
+		}
+		cmd.SilenceUsage = true
+
+		path, err := resolvePath(restoreFromFlag)
+		if err != nil {
+			return err
+		}
+		file, err := os.Open(path)
+		if err != nil {
+			return fmt.Errorf("could not open file %s: %w", restoreFromFlag, err)
+		}
+		defer file.Close()
+
+		client, err := authedTursoClient()
+		if err != nil {
+			return err
+		}
+
+		db, err := databaseFromName(args[0], client)
+		if err != nil {
+			return err
+		}
+
+		token, err := tokenFromDb(db, client, nil)
+		if err != nil {
+			return err
+		}
+
+		dbURL := getDatabaseHttpUrl(db)
+		tables, err := listTables(dbURL, token)
+		if err != nil {
+			return fmt.Errorf("could not list tables of database %s: %w", db.Name, err)
+		}
+
+		if len(tables) > 0 && !yesFlag {
+			fmt.Printf("Database %s already has %d tables: %s\n", internal.Emph(db.Name), len(tables), strings.Join(tables, ", "))
+			ok, err := promptConfirmation("Are you sure you want to restore the dump into it?")
+			if err != nil {
+				return fmt.Errorf("could not get prompt confirmed by user: %w", err)
+			}
+			if !ok {
+				fmt.Println("Restore cancelled by the user.")
+				return nil
+			}
+		}
+
+		spinner := prompt.Spinner(fmt.Sprintf("Restoring %s into database %s...", internal.Emph(restoreFromFlag), internal.Emph(db.Name)))
+		defer spinner.Stop()
+
+		count := 0
+		batch := make([]sqlStatement, 0, restoreBatchSize)
+		flush := func() error {
+			if err := executeBatch(dbURL, token, batch); err != nil {
+				return err
+			}
+			count += len(batch)
+			batch = batch[:0]
+			spinner.Text(fmt.Sprintf("Restoring %s into database %s... %d statements executed", internal.Emph(restoreFromFlag), internal.Emph(db.Name), count))
+			return nil
+		}
+
+		scanner := newStatementScanner(file)
+		for scanner.Scan() {
+			stmt := scanner.Statement()
+			if dumpTransactionRegex.MatchString(stmt.SQL) {
+				continue
+			}
+			batch = append(batch, stmt)
+			if len(batch) == restoreBatchSize {
+				if err := flush(); err != nil {
+					return err
+				}
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			return fmt.Errorf("could not read file %s: %w", restoreFromFlag, err)
+		}
+		if err := flush(); err != nil {
+			return err
+		}
+
+		spinner.Stop()
+		fmt.Printf("Restored %d statements from %s into database %s.\n", count, internal.Emph(restoreFromFlag), internal.Emph(db.Name))
+		return nil
+	},
+}
+
+func executeBatch(dbURL, token string, batch []sqlStatement) error {
+	if len(batch) == 0 {
+		return nil
+	}
+
+	statements := make([]string, 0, len(batch))
+	for _, stmt := range batch {
+		statements = append(statements, stmt.SQL)
+	}
+
+	results, err := executeStatements(dbURL, token, statements)
+	if err != nil {
+		return fmt.Errorf("failed to execute statements starting at line %d: %w", batch[0].Line, err)
+	}
+
+	for i, result := range results {
+		if result.Error != nil && i < len(batch) {
+			return fmt.Errorf("failed to execute statement at line %d: %s\n\n%s", batch[i].Line, result.Error.Message, batch[i].SQL)
+		}
+	}
+	return nil
+}
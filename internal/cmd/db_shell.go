@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"sort"
 	"strings"
 	"time"
 
@@ -18,18 +19,37 @@ import (
 	"github.com/tursodatabase/turso-cli/internal/prompt"
 	"github.com/tursodatabase/turso-cli/internal/settings"
 	"github.com/tursodatabase/turso-cli/internal/turso"
+	"golang.org/x/exp/slices"
 )
 
-var proxy string
+var (
+	proxy           string
+	echoFlag        bool
+	databaseURLFlag string
+)
 
 func init() {
 	dbCmd.AddCommand(shellCmd)
 	addInstanceFlag(shellCmd, "Connect to the database at the specified instance.")
+	shellCmd.RegisterFlagCompletionFunc("instance", completeInstanceName)
 	addLocationFlag(shellCmd, "Connect to the database at the specified location.")
+	shellCmd.MarkFlagsMutuallyExclusive("instance", "location")
 	shellCmd.Flags().StringVar(&proxy, "proxy", "", "Proxy to use for the connection.")
 	shellCmd.RegisterFlagCompletionFunc("proxy", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 		return []string{}, cobra.ShellCompDirectiveNoFileComp
 	})
+	shellCmd.Flags().StringVar(&databaseURLFlag, "database-url", "", "Connect directly to the database at this URL, without looking it up in your Turso account. Pass the token as the authToken query parameter.")
+	shellCmd.RegisterFlagCompletionFunc("database-url", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return []string{}, cobra.ShellCompDirectiveNoFileComp
+	})
+	shellCmd.Flags().BoolVar(&echoFlag, "echo", false, "Print each statement to stderr before its results when running SQL from arguments or stdin.")
+	addShellOutputFlags(shellCmd)
+	addPagerFlag(shellCmd)
+	addSafeFlag(shellCmd)
+	addVariableFlag(shellCmd)
+	addExplainFlags(shellCmd)
+	addJSONLinesFlag(shellCmd)
+	addConnectTimeoutFlag(shellCmd)
 	flags.AddAttachClaims(shellCmd)
 }
 
@@ -47,19 +67,22 @@ func getURL(db *turso.Database, client *turso.Client, http bool) (string, error)
 	if err != nil {
 		return "", err
 	}
+	names := make([]string, 0, len(instances))
 	for _, instance := range instances {
-		if instance.Region == locationFlag || instance.Name == instanceFlag {
+		if instanceFlag != "" && instance.Name == instanceFlag {
+			return getUrl(db, &instance, scheme), nil
+		}
+		if instanceFlag == "" && instance.Region == locationFlag {
 			return getUrl(db, &instance, scheme), nil
 		}
+		names = append(names, instance.Name)
 	}
 
-	if locationFlag != "" {
-		return "", fmt.Errorf("location %s for db %s not found", locationFlag, db.Name)
-	}
 	if instanceFlag != "" {
-		return "", fmt.Errorf("instance %s for db %s not found", instanceFlag, db.Name)
+		sort.Strings(names)
+		return "", fmt.Errorf("database %s has no instance named %s. Its instances are: %s", internal.Emph(db.Name), internal.Emph(instanceFlag), strings.Join(names, ", "))
 	}
-	return "", fmt.Errorf("impossible")
+	return "", fmt.Errorf("location %s for db %s not found", locationFlag, db.Name)
 }
 
 func getDbURLForDump(u string) string {
@@ -70,19 +93,37 @@ func getDbURLForDump(u string) string {
 }
 
 var shellCmd = &cobra.Command{
-	Use:               "shell <database-name | replica-url> [sql]",
+	Use:               "shell [{<database-name | replica-url> | --database-url <url>} [sql]]",
 	Short:             "Start a SQL shell.",
 	Long:              "Start a SQL shell.\nWhen database-name is provided, the shell will connect the closest replica of the specified database.\nWhen the --instance flag is provided with a specific instance name, the shell will connect to that instance directly.",
-	Example:           "  turso db shell http://127.0.0.1:8080\n  turso db shell name-of-my-amazing-db\n  turso db shell name-of-my-amazing-db --location yyz\n  turso db shell name-of-my-amazing-db --instance a-specific-instance\n  turso db shell name-of-my-amazing-db \"select * from users;\"",
-	Args:              cobra.RangeArgs(1, 2),
+	Example:           "  turso db shell http://127.0.0.1:8080\n  turso db shell name-of-my-amazing-db\n  turso db shell name-of-my-amazing-db --location yyz\n  turso db shell name-of-my-amazing-db --instance a-specific-instance\n  turso db shell name-of-my-amazing-db \"select * from users;\"\n  turso db shell name-of-my-amazing-db \"select * from users;\" --output-file users.csv --output-format csv\n  turso db shell --database-url \"libsql://my-db.example.com?authToken=$TOKEN\"",
+	Args:              shellArgs,
 	ValidArgsFunction: dbNameArg,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if databaseURLFlag != "" {
+			if err := validateDatabaseURL(databaseURLFlag); err != nil {
+				return err
+			}
+			args = append([]string{databaseURLFlag}, args...)
+		}
+		args, err := withDefaultDatabase(args)
+		if err != nil {
+			return err
+		}
 		nameOrUrl := args[0]
 		if nameOrUrl == "" {
 			return fmt.Errorf("please specify a database name")
 		}
 		cmd.SilenceUsage = true
 
+		if err := validateShellOutputFlags(); err != nil {
+			return err
+		}
+		shellVariables, err = parseVariables(variableFlags)
+		if err != nil {
+			return err
+		}
+
 		spinner := prompt.StoppedSpinner("Connecting to database")
 		if len(args) == 1 {
 			spinner.Start()
@@ -94,6 +135,27 @@ var shellCmd = &cobra.Command{
 		var db *turso.Database = nil
 		var authToken string
 		nonInteractive := pipeOrRedirect()
+		if !nonInteractive && len(args) == 1 {
+			for _, flag := range append([]shellFlag{{"--output-file", outputFileFlag != ""}}, scriptFlags()...) {
+				if flag.set {
+					return fmt.Errorf("%s is not supported in the interactive shell, pass SQL statements as an argument or from stdin", flag.name)
+				}
+			}
+		}
+		if jsonLinesFlag && explaining() {
+			return fmt.Errorf("--json-lines can't be used with --explain")
+		}
+		if (instanceFlag != "" || locationFlag != "") && isURL(nameOrUrl) {
+			return fmt.Errorf("--instance and --location require a database name, not a URL")
+		}
+		if safeFlag && !nonInteractive && len(args) == 1 && isURL(nameOrUrl) {
+			return fmt.Errorf("--safe in the interactive shell requires a database name, so that a read-only token can be used")
+		}
+		if safeFlag && len(args) == 2 && args[1] != ".dump" {
+			if err := checkSafe(args[1]); err != nil {
+				return err
+			}
+		}
 		// Makes sure localhost URL or self-hosted will work even if not authenticated
 		// to turso. The token code will check for auth
 		if !isURL(nameOrUrl) {
@@ -118,11 +180,15 @@ var shellCmd = &cobra.Command{
 				}
 			}
 
-			authToken, err = tokenFromDb(db, client, claim)
+			if safeFlag {
+				authToken, err = client.Databases.Token(db.Name, "2d", true, claim)
+			} else {
+				authToken, err = tokenFromDb(db, client, claim)
+			}
 			if err != nil {
 				return err
 			}
-			dbUrl, err = getURL(db, client, nonInteractive)
+			dbUrl, err = getURL(db, client, nonInteractive || outputFileFlag != "" || runsAsScript())
 			if err != nil {
 				return err
 			}
@@ -158,7 +224,7 @@ var shellCmd = &cobra.Command{
 				authToken = authTokenCamel
 			} else if jwt != "" {
 				authToken = jwt
-			} else if strings.HasSuffix(u.Hostname(), ".turso.io") {
+			} else if strings.HasSuffix(u.Hostname(), ".turso.io") && databaseURLFlag == "" {
 				client, err := authedTursoClient()
 				if err != nil {
 					return fmt.Errorf("could not create turso client: %w", err)
@@ -184,6 +250,12 @@ var shellCmd = &cobra.Command{
 			dbUrl = u.String()
 		}
 
+		if connectTimeoutFlag > 0 {
+			if err := checkConnection(dbUrl, connectTimeoutFlag); err != nil {
+				return err
+			}
+		}
+
 		connectionInfo := getConnectionInfo(urlString, db)
 
 		shellConfig := shell.ShellConfig{
@@ -213,6 +285,20 @@ var shellCmd = &cobra.Command{
 			if args[1] == ".dump" {
 				return dump(getDbURLForDump(dbUrl), authToken)
 			}
+			if outputFileFlag != "" {
+				return runToOutputFile(getDbURLForDump(dbUrl), authToken, args[1])
+			}
+			if runsAsScript() {
+				_, _, err := runScript(getDbURLForDump(dbUrl), authToken, args[1], os.Stdout)
+				return err
+			}
+			if shouldPage() {
+				return paged(func(w io.Writer) error {
+					config := shellConfig
+					config.OutF = w
+					return runShellLine(dbID, config, args[1])
+				})
+			}
 			return runShellLine(dbID, shellConfig, args[1])
 		}
 
@@ -222,12 +308,81 @@ var shellCmd = &cobra.Command{
 			if err != nil {
 				return fmt.Errorf("error reading from stdin: %w", err)
 			}
+			if safeFlag {
+				if err := checkSafe(string(b)); err != nil {
+					return err
+				}
+			}
+			if outputFileFlag != "" || runsAsScript() {
+				spinner.Stop()
+			}
+			if outputFileFlag != "" {
+				return runToOutputFile(getDbURLForDump(dbUrl), authToken, string(b))
+			}
+			if runsAsScript() {
+				_, _, err := runScript(getDbURLForDump(dbUrl), authToken, string(b), os.Stdout)
+				return err
+			}
 			return runShellLine(dbID, shellConfig, string(b))
 		}
+
+		releaseInterrupts()
 		return runShell(dbID, shellConfig)
 	},
 }
 
+type shellFlag struct {
+	name string
+	set  bool
+}
+
+// scriptFlags returns the flags that need the statements to be executed by
+// runScript instead of libsql-shell-go, and whether each of them is set.
+func scriptFlags() []shellFlag {
+	return []shellFlag{
+		{"--echo", echoFlag},
+		{"--transaction", transactionFlag},
+		{"--show-types", showTypesFlag},
+		{"--maxrows", maxRowsFlag > 0},
+		{"--variable", len(shellVariables) > 0},
+		{"--explain", explainFlag},
+		{"--explain-only", explainOnlyFlag},
+		{"--json-lines", jsonLinesFlag},
+	}
+}
+
+// runsAsScript reports whether the flags given need the statements to be
+// executed by runScript instead of libsql-shell-go.
+func runsAsScript() bool {
+	return slices.ContainsFunc(scriptFlags(), func(flag shellFlag) bool { return flag.set })
+}
+
+func shellArgs(cmd *cobra.Command, args []string) error {
+	if databaseURLFlag != "" {
+		if len(args) > 1 {
+			return fmt.Errorf("accepts at most 1 arg when using --database-url, received %d", len(args))
+		}
+		return nil
+	}
+	return cobra.RangeArgs(0, 2)(cmd, args)
+}
+
+var databaseURLSchemes = []string{"libsql", "wss", "ws", "https", "http"}
+
+func validateDatabaseURL(dbURL string) error {
+	u, err := url.Parse(dbURL)
+	if err != nil {
+		return fmt.Errorf("invalid database URL %s: %w", dbURL, err)
+	}
+	if !slices.Contains(databaseURLSchemes, u.Scheme) {
+		return fmt.Errorf("invalid database URL %s: scheme must be one of %s", dbURL, strings.Join(databaseURLSchemes, ", "))
+	}
+	if u.Host == "" {
+		return fmt.Errorf("invalid database URL %s: missing host", dbURL)
+	}
+	return nil
+}
+
 func runShell(dbID string, config shell.ShellConfig) error {
 	err := shell.RunShell(config)
 	if isAuthError(err) && dbID != "" {
@@ -339,7 +494,11 @@ func pipeOrRedirect() bool {
 }
 
 func dump(dbURL, authToken string) error {
-	req, err := http.NewRequest("GET", dbURL+"/dump", nil)
+	return dumpTo(os.Stdout, dbURL, authToken)
+}
+
+func dumpTo(w io.Writer, dbURL, authToken string) error {
+	req, err := http.NewRequestWithContext(commandContext(), "GET", dbURL+"/dump", nil)
 	if err != nil {
 		return err
 	}
@@ -350,13 +509,19 @@ func dump(dbURL, authToken string) error {
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to dump database: %s", resp.Status)
+	}
+
 	reader := bufio.NewReader(resp.Body)
 	for {
 		line, err := reader.ReadString('\n')
 		if err != nil && err != io.EOF {
 			return err
 		}
-		fmt.Print(line)
+		if _, werr := io.WriteString(w, line); werr != nil {
+			return werr
+		}
 		if err == io.EOF {
 			return nil
 		}
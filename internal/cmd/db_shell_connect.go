@@ -0,0 +1,43 @@
+package cmd
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+var connectTimeoutFlag time.Duration
+
+func addConnectTimeoutFlag(cmd *cobra.Command) {
+	cmd.Flags().DurationVar(&connectTimeoutFlag, "connect-timeout", 0, "Fail if a connection to the database can't be opened within this time, for example 5s. By default there is no limit.")
+	cmd.MarkFlagsMutuallyExclusive("connect-timeout", "proxy")
+}
+
+// checkConnection opens and closes a connection to the host of dbURL,
+// failing if it takes longer than timeout. Queries have no part in it, so a
+// wrong or unreachable URL is told apart from a slow query.
+func checkConnection(dbURL string, timeout time.Duration) error {
+	u, err := url.Parse(dbURL)
+	if err != nil {
+		return fmt.Errorf("invalid database URL %s: %w", dbURL, err)
+	}
+	port := u.Port()
+	if port == "" {
+		port = "443"
+		if u.Scheme == "ws" || u.Scheme == "http" {
+			port = "80"
+		}
+	}
+	addr := net.JoinHostPort(u.Hostname(), port)
+	conn, err := net.DialTimeout("tcp", addr, timeout)
+	if err != nil {
+		if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
+			return fmt.Errorf("could not connect to %s within %s", addr, timeout)
+		}
+		return fmt.Errorf("could not connect to %s: %w", addr, err)
+	}
+	return conn.Close()
+}
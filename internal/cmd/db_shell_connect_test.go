@@ -0,0 +1,28 @@
+package cmd
+
+import (
+	"net"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCheckConnection(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := listener.Addr().String()
+	if err := checkConnection("http://"+addr, time.Second); err != nil {
+		t.Fatalf("expected to connect to %s, got %v", addr, err)
+	}
+
+	listener.Close()
+	err = checkConnection("libsql://"+addr+"?authToken=secret", time.Second)
+	if err == nil || !strings.Contains(err.Error(), addr) {
+		t.Fatalf("expected a connection error naming %s, got %v", addr, err)
+	}
+	if strings.Contains(err.Error(), "secret") {
+		t.Errorf("expected the token not to be in the error, got %v", err)
+	}
+}
@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	explainFlag     bool
+	explainOnlyFlag bool
+)
+
+func addExplainFlags(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&explainFlag, "explain", false, "Print the query plan of each SELECT before its results. Only for SQL from arguments or stdin, the interactive shell doesn't support it.")
+	cmd.Flags().BoolVar(&explainOnlyFlag, "explain-only", false, "Print the query plan of each SELECT instead of running it. Only for SQL from arguments or stdin, the interactive shell doesn't support it.")
+}
+
+func explaining() bool {
+	return explainFlag || explainOnlyFlag
+}
+
+// isQuery reports whether sql is a query, whose rows can be paged and whose
+// plan can be explained.
+func isQuery(sql string) bool {
+	keyword := leadingKeyword(sql)
+	return keyword == "SELECT" || keyword == "WITH" || keyword == "VALUES"
+}
+
+type queryPlanStep struct {
+	id     string
+	parent string
+	detail string
+}
+
+// writeQueryPlan writes the rows of an EXPLAIN QUERY PLAN as a tree, the way
+// the sqlite3 shell does.
+func writeQueryPlan(w io.Writer, rs *ResultSet) {
+	steps := make([]queryPlanStep, 0, len(rs.Rows))
+	for _, row := range rs.Rows {
+		if len(row) < 4 {
+			continue
+		}
+		steps = append(steps, queryPlanStep{id: fmt.Sprint(row[0]), parent: fmt.Sprint(row[1]), detail: fmt.Sprint(row[3])})
+	}
+
+	var sb strings.Builder
+	sb.WriteString("QUERY PLAN\n")
+	writeQueryPlanChildren(&sb, steps, "0", "")
+	fmt.Fprint(w, sb.String())
+}
+
+func writeQueryPlanChildren(sb *strings.Builder, steps []queryPlanStep, parent, indent string) {
+	var children []queryPlanStep
+	for _, step := range steps {
+		if step.parent == parent {
+			children = append(children, step)
+		}
+	}
+	for i, child := range children {
+		branch, nested := "|--", "|  "
+		if i == len(children)-1 {
+			branch, nested = "`--", "   "
+		}
+		sb.WriteString(indent + branch + child.detail + "\n")
+		writeQueryPlanChildren(sb, steps, child.id, indent+nested)
+	}
+}
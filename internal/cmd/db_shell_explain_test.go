@@ -0,0 +1,31 @@
+package cmd
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestWriteQueryPlan(t *testing.T) {
+	rs := &ResultSet{
+		Columns: []string{"id", "parent", "notused", "detail"},
+		Rows: []Row{
+			{json.Number("2"), json.Number("0"), json.Number("0"), "SCAN users"},
+			{json.Number("5"), json.Number("0"), json.Number("0"), "SEARCH orders USING INDEX orders_user (user_id=?)"},
+			{json.Number("9"), json.Number("5"), json.Number("0"), "CORRELATED SCALAR SUBQUERY 1"},
+			{json.Number("20"), json.Number("0"), json.Number("0"), "USE TEMP B-TREE FOR ORDER BY"},
+		},
+	}
+
+	var sb strings.Builder
+	writeQueryPlan(&sb, rs)
+
+	expected := "QUERY PLAN\n" +
+		"|--SCAN users\n" +
+		"|--SEARCH orders USING INDEX orders_user (user_id=?)\n" +
+		"|  `--CORRELATED SCALAR SUBQUERY 1\n" +
+		"`--USE TEMP B-TREE FOR ORDER BY\n"
+	if sb.String() != expected {
+		t.Fatalf("expected:\n%s\ngot:\n%s", expected, sb.String())
+	}
+}
@@ -0,0 +1,228 @@
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var jsonLinesFlag bool
+
+func addJSONLinesFlag(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&jsonLinesFlag, "json-lines", false, "Write each row as a JSON object on its own line, when running SQL from arguments or stdin.")
+	cmd.MarkFlagsMutuallyExclusive("json-lines", "output-format")
+	cmd.MarkFlagsMutuallyExclusive("json-lines", "transaction")
+	cmd.MarkFlagsMutuallyExclusive("json-lines", "show-types")
+}
+
+// runJSONLines executes the statements in sql one at a time, writing the rows
+// they return to w as JSON lines. Each statement runs in a single request
+// whose response is decoded a row at a time, so rows are written as they
+// arrive and memory use doesn't grow with the size of the result.
+func runJSONLines(dbURL, authToken, sql string, w io.Writer) (int, int, error) {
+	var statements []sqlStatement
+	scanner := newStatementScanner(strings.NewReader(sql))
+	for scanner.Scan() {
+		statements = append(statements, scanner.Statement())
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, 0, err
+	}
+	if len(statements) == 0 {
+		return 0, 0, fmt.Errorf("no SQL command to execute")
+	}
+
+	bw := bufio.NewWriter(w)
+	defer bw.Flush()
+
+	rows := 0
+	for _, statement := range statements {
+		sql, params, err := substituteVariables(statement.SQL, shellVariables)
+		if err != nil {
+			return 0, rows, fmt.Errorf("statement at line %d: %w", statement.Line, err)
+		}
+		if echoFlag {
+			if err := bw.Flush(); err != nil {
+				return 0, rows, fmt.Errorf("could not write results: %w", err)
+			}
+			fmt.Fprintf(os.Stderr, "%s;\n", statement.SQL)
+		}
+		n, err := streamJSONLines(bw, dbURL, authToken, sql, params)
+		rows += n
+		if err != nil {
+			return 0, rows, fmt.Errorf("statement at line %d failed: %w\n%s", statement.Line, err, statement.SQL)
+		}
+	}
+	if err := bw.Flush(); err != nil {
+		return 0, rows, fmt.Errorf("could not write results: %w", err)
+	}
+	fmt.Fprintf(os.Stderr, "%d rows\n", rows)
+	return len(statements), rows, nil
+}
+
+func streamJSONLines(w *bufio.Writer, dbURL, authToken, sql string, params []interface{}) (int, error) {
+	rows := 0
+	err := streamStatement(dbURL, authToken, paramStatement{SQL: sql, Params: params}, func(columns []string, row Row) error {
+		if maxRowsFlag > 0 && rows == maxRowsFlag {
+			return errStopStreaming
+		}
+		if err := writeJSONLine(w, columns, row); err != nil {
+			return fmt.Errorf("could not write results: %w", err)
+		}
+		rows++
+		return nil
+	})
+	if errors.Is(err, errStopStreaming) {
+		return rows, nil
+	}
+	return rows, err
+}
+
+var errStopStreaming = errors.New("stop streaming")
+
+// streamStatement runs statement and calls fn with each row of its result as
+// the response is decoded, instead of holding the whole result in memory.
+// Returning an error from fn stops reading the response.
+func streamStatement(dbURL, authToken string, statement paramStatement, fn func(columns []string, row Row) error) error {
+	resp, err := sendStatements(dbURL, authToken, struct {
+		Statements []paramStatement `json:"statements"`
+	}{[]paramStatement{statement}})
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	decoder := json.NewDecoder(resp.Body)
+	decoder.UseNumber()
+	if err := expectDelim(decoder, '['); err != nil {
+		return err
+	}
+	if err := expectDelim(decoder, '{'); err != nil {
+		return err
+	}
+	for decoder.More() {
+		key, err := decoder.Token()
+		if err != nil {
+			return fmt.Errorf("failed to deserialize query response: %w", err)
+		}
+		switch key {
+		case "error":
+			var queryErr *Error
+			if err := decoder.Decode(&queryErr); err != nil {
+				return fmt.Errorf("failed to deserialize query response: %w", err)
+			}
+			if queryErr != nil {
+				return fmt.Errorf("%s", queryErr.Message)
+			}
+		case "results":
+			if err := streamResultSet(decoder, fn); err != nil {
+				return err
+			}
+		default:
+			var skip json.RawMessage
+			if err := decoder.Decode(&skip); err != nil {
+				return fmt.Errorf("failed to deserialize query response: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+func streamResultSet(decoder *json.Decoder, fn func(columns []string, row Row) error) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return fmt.Errorf("failed to deserialize query response: %w", err)
+	}
+	if token == nil {
+		return nil
+	}
+	if token != json.Delim('{') {
+		return fmt.Errorf("failed to deserialize query response: unexpected %v", token)
+	}
+
+	var columns []string
+	for decoder.More() {
+		key, err := decoder.Token()
+		if err != nil {
+			return fmt.Errorf("failed to deserialize query response: %w", err)
+		}
+		switch key {
+		case "columns":
+			if err := decoder.Decode(&columns); err != nil {
+				return fmt.Errorf("failed to deserialize query response: %w", err)
+			}
+		case "rows":
+			if columns == nil {
+				return fmt.Errorf("failed to deserialize query response: rows before columns")
+			}
+			if err := expectDelim(decoder, '['); err != nil {
+				return err
+			}
+			for decoder.More() {
+				var row Row
+				if err := decoder.Decode(&row); err != nil {
+					return fmt.Errorf("failed to deserialize query response: %w", err)
+				}
+				if err := fn(columns, row); err != nil {
+					return err
+				}
+			}
+			if err := expectDelim(decoder, ']'); err != nil {
+				return err
+			}
+		default:
+			var skip json.RawMessage
+			if err := decoder.Decode(&skip); err != nil {
+				return fmt.Errorf("failed to deserialize query response: %w", err)
+			}
+		}
+	}
+	return expectDelim(decoder, '}')
+}
+
+func expectDelim(decoder *json.Decoder, delim json.Delim) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return fmt.Errorf("failed to deserialize query response: %w", err)
+	}
+	if token != delim {
+		return fmt.Errorf("failed to deserialize query response: expected %v, got %v", delim, token)
+	}
+	return nil
+}
+
+// writeJSONLine writes row as a JSON object with its keys in column order.
+func writeJSONLine(w io.Writer, columns []string, row Row) error {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, column := range columns {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, err := json.Marshal(column)
+		if err != nil {
+			return err
+		}
+		var value interface{}
+		if i < len(row) {
+			value = row[i]
+		}
+		b, err := json.Marshal(value)
+		if err != nil {
+			return err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(b)
+	}
+	buf.WriteString("}\n")
+	_, err := w.Write(buf.Bytes())
+	return err
+}
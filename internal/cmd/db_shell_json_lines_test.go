@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestStreamJSONLines(t *testing.T) {
+	tests := []struct {
+		name     string
+		response string
+		maxRows  int
+		want     string
+		rows     int
+		err      string
+	}{
+		{
+			name:     "rows",
+			response: `[{"results":{"columns":["id","name"],"rows":[[1,"a"],[2,null],[3,"c"]]},"error":null}]`,
+			want:     "{\"id\":1,\"name\":\"a\"}\n{\"id\":2,\"name\":null}\n{\"id\":3,\"name\":\"c\"}\n",
+			rows:     3,
+		},
+		{
+			name:     "max rows",
+			response: `[{"results":{"columns":["id"],"rows":[[1],[2],[3]]}}]`,
+			maxRows:  2,
+			want:     "{\"id\":1}\n{\"id\":2}\n",
+			rows:     2,
+		},
+		{
+			name:     "no results",
+			response: `[{"results":null}]`,
+		},
+		{
+			name:     "error",
+			response: `[{"error":{"message":"no such table: t"}}]`,
+			err:      "no such table: t",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(tt.response))
+			}))
+			defer server.Close()
+			maxRowsFlag = tt.maxRows
+			defer func() { maxRowsFlag = 0 }()
+
+			var buf bytes.Buffer
+			w := bufio.NewWriter(&buf)
+			rows, err := streamJSONLines(w, server.URL, "token", "SELECT * FROM t", nil)
+			w.Flush()
+			if tt.err != "" {
+				if err == nil || err.Error() != tt.err {
+					t.Fatalf("expected error %q, got %v", tt.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if rows != tt.rows {
+				t.Errorf("expected %d rows, got %d", tt.rows, rows)
+			}
+			if buf.String() != tt.want {
+				t.Errorf("expected\n%s\ngot\n%s", tt.want, buf.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,309 @@
+package cmd
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/tursodatabase/turso-cli/internal"
+)
+
+const (
+	shellFormatTable = "table"
+	shellFormatCSV   = "csv"
+	shellFormatJSON  = "json"
+)
+
+var (
+	outputFileFlag   string
+	appendFlag       bool
+	outputFormatFlag string
+	transactionFlag  bool
+	showTypesFlag    bool
+	maxRowsFlag      int
+)
+
+func addShellOutputFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&outputFileFlag, "output-file", "", "Write query results to the given file instead of stdout.")
+	cmd.Flags().BoolVar(&appendFlag, "append", false, "Append to the --output-file instead of truncating it.")
+	cmd.Flags().StringVar(&outputFormatFlag, "output-format", shellFormatTable, "Format of the results written to --output-file: table, csv or json.")
+	cmd.Flags().BoolVar(&transactionFlag, "transaction", false, "Run the SQL from arguments or stdin in a single transaction, committing none of it if a statement fails.")
+	cmd.Flags().BoolVar(&showTypesFlag, "show-types", false, "Show the type of each column in table headers and print NULL values as (null). Only for SQL from arguments or stdin, the interactive shell doesn't support it.")
+	cmd.Flags().IntVar(&maxRowsFlag, "maxrows", 0, "Print at most this many rows of each result. 0 prints all rows. Only for SQL from arguments or stdin, the interactive shell doesn't support it.")
+	cmd.RegisterFlagCompletionFunc("output-format", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return []string{shellFormatTable, shellFormatCSV, shellFormatJSON}, cobra.ShellCompDirectiveNoFileComp
+	})
+}
+
+func validateShellOutputFlags() error {
+	if maxRowsFlag < 0 {
+		return fmt.Errorf("--maxrows must not be negative")
+	}
+	if outputFileFlag == "" {
+		if appendFlag {
+			return fmt.Errorf("--append can only be used with --output-file")
+		}
+		return nil
+	}
+	switch outputFormatFlag {
+	case shellFormatTable, shellFormatCSV, shellFormatJSON:
+		return nil
+	default:
+		return fmt.Errorf("invalid output format %s. Valid formats are %s, %s and %s", outputFormatFlag, shellFormatTable, shellFormatCSV, shellFormatJSON)
+	}
+}
+
+// runToOutputFile executes the statements in sql and writes their results to
+// --output-file, printing only a summary to stdout.
+func runToOutputFile(dbURL, authToken, sql string) error {
+	mode := os.O_CREATE | os.O_WRONLY | os.O_TRUNC
+	if appendFlag {
+		mode = os.O_CREATE | os.O_WRONLY | os.O_APPEND
+	}
+	path, err := resolveOutputPath(outputFileFlag)
+	if err != nil {
+		return err
+	}
+	f, err := os.OpenFile(path, mode, 0o644)
+	if err != nil {
+		return fmt.Errorf("could not open output file: %w", err)
+	}
+	defer f.Close()
+
+	statements, rows, err := runScript(dbURL, authToken, sql, f)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Executed %d statements, wrote %d rows to %s.\n", statements, rows, internal.Emph(outputFileFlag))
+	return nil
+}
+
+// runScript executes the statements in sql in a single request, writing the
+// results of the ones that return rows to w. Variables set with --variable
+// are substituted in each statement. With --transaction, the
+// statements are wrapped in BEGIN and COMMIT so that they are applied all
+// together or not at all. With --explain, the query plan of each SELECT is
+// requested along with it. With --echo, each statement is printed to stderr
+// before its results.
+func runScript(dbURL, authToken, sql string, w io.Writer) (int, int, error) {
+	if jsonLinesFlag {
+		return runJSONLines(dbURL, authToken, sql, w)
+	}
+	var statements []sqlStatement
+	scanner := newStatementScanner(strings.NewReader(sql))
+	for scanner.Scan() {
+		statements = append(statements, scanner.Statement())
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, 0, err
+	}
+	if len(statements) == 0 {
+		return 0, 0, fmt.Errorf("no SQL command to execute")
+	}
+	if transactionFlag {
+		statements = wrapInTransaction(statements)
+	}
+
+	requests := make([]paramStatement, 0, len(statements))
+	// sources maps each request to its statement, and plans marks the
+	// requests that are an EXPLAIN QUERY PLAN.
+	sources := make([]int, 0, len(statements))
+	plans := make([]bool, 0, len(statements))
+	for i, statement := range statements {
+		sql, params, err := substituteVariables(statement.SQL, shellVariables)
+		if err != nil {
+			return 0, 0, fmt.Errorf("statement at line %d: %w", statement.Line, err)
+		}
+		explain := explaining() && isQuery(sql)
+		if explain {
+			requests = append(requests, paramStatement{SQL: "EXPLAIN QUERY PLAN " + sql, Params: params})
+			sources = append(sources, i)
+			plans = append(plans, true)
+		}
+		if explain && explainOnlyFlag {
+			continue
+		}
+		requests = append(requests, paramStatement{SQL: sql, Params: params})
+		sources = append(sources, i)
+		plans = append(plans, false)
+	}
+	results, err := executeParamStatements(dbURL, authToken, requests)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	rows := 0
+	echoed := -1
+	for i, result := range results {
+		statement := statements[sources[i]]
+		if echoFlag && sources[i] != echoed {
+			fmt.Fprintf(os.Stderr, "%s;\n", statement.SQL)
+			echoed = sources[i]
+		}
+		if result.Error != nil {
+			if transactionFlag {
+				return 0, 0, fmt.Errorf("statement at line %d failed, so the transaction was not committed: %s\n%s", statement.Line, result.Error.Message, statement.SQL)
+			}
+			return 0, 0, fmt.Errorf("statement at line %d failed: %s\n%s", statement.Line, result.Error.Message, statement.SQL)
+		}
+		if result.Results == nil || len(result.Results.Columns) == 0 {
+			continue
+		}
+		if plans[i] {
+			writeQueryPlan(noticeWriter(w), result.Results)
+			continue
+		}
+		rs, truncated := truncateResultSet(result.Results, maxRowsFlag)
+		if err := writeResultSet(w, rs); err != nil {
+			return 0, 0, fmt.Errorf("could not write results: %w", err)
+		}
+		if truncated {
+			fmt.Fprintf(noticeWriter(w), "... truncated to %d of %d rows (use --maxrows 0 for all)\n", maxRowsFlag, len(result.Results.Rows))
+		}
+		rows += len(rs.Rows)
+	}
+	return len(statements), rows, nil
+}
+
+// noticeWriter returns where to write messages about the results, so that
+// they don't end up in CSV or JSON output.
+func noticeWriter(w io.Writer) io.Writer {
+	if outputFormatFlag == shellFormatTable {
+		return w
+	}
+	return os.Stderr
+}
+
+// wrapInTransaction surrounds statements with BEGIN and COMMIT. Transaction
+// statements already in the script would fail as nested transactions, so they
+// are dropped with a warning.
+func wrapInTransaction(statements []sqlStatement) []sqlStatement {
+	wrapped := make([]sqlStatement, 0, len(statements)+2)
+	wrapped = append(wrapped, sqlStatement{SQL: "BEGIN", Line: statements[0].Line})
+	dropped := 0
+	for _, statement := range statements {
+		if dumpTransactionRegex.MatchString(statement.SQL) {
+			dropped++
+			continue
+		}
+		wrapped = append(wrapped, statement)
+	}
+	wrapped = append(wrapped, sqlStatement{SQL: "COMMIT", Line: statements[len(statements)-1].Line})
+	if dropped > 0 {
+		warnf("the script manages its own transactions, ignoring %d BEGIN/COMMIT statements to avoid nesting them in --transaction.", dropped)
+	}
+	return wrapped
+}
+
+// truncateResultSet returns rs with at most limit rows, and whether rows were
+// dropped. A limit of 0 keeps all rows.
+func truncateResultSet(rs *ResultSet, limit int) (*ResultSet, bool) {
+	if limit == 0 || len(rs.Rows) <= limit {
+		return rs, false
+	}
+	return &ResultSet{Columns: rs.Columns, Rows: rs.Rows[:limit]}, true
+}
+
+func writeResultSet(w io.Writer, rs *ResultSet) error {
+	switch outputFormatFlag {
+	case shellFormatCSV:
+		cw := csv.NewWriter(w)
+		if err := cw.Write(rs.Columns); err != nil {
+			return err
+		}
+		for _, row := range rs.Rows {
+			if err := cw.Write(formatRow(row)); err != nil {
+				return err
+			}
+		}
+		cw.Flush()
+		return cw.Error()
+	case shellFormatJSON:
+		objects := make([]map[string]interface{}, 0, len(rs.Rows))
+		for _, row := range rs.Rows {
+			object := make(map[string]interface{}, len(rs.Columns))
+			for i, column := range rs.Columns {
+				if i < len(row) {
+					object[column] = row[i]
+				}
+			}
+			objects = append(objects, object)
+		}
+		return json.NewEncoder(w).Encode(objects)
+	default:
+		data := make([][]string, 0, len(rs.Rows))
+		for _, row := range rs.Rows {
+			data = append(data, formatRow(row))
+		}
+		header := rs.Columns
+		if showTypesFlag {
+			header = typedColumns(rs)
+		}
+		writeTable(w, header, data)
+		return nil
+	}
+}
+
+// typedColumns appends to each column name the type of its values. The HTTP
+// API doesn't report declared types, so the type is that of the non-NULL
+// values in the column, or MIXED if they differ.
+func typedColumns(rs *ResultSet) []string {
+	columns := make([]string, 0, len(rs.Columns))
+	for i, column := range rs.Columns {
+		columnType := ""
+		for _, row := range rs.Rows {
+			if i >= len(row) || row[i] == nil {
+				continue
+			}
+			valueType := valueTypeName(row[i])
+			if columnType == "" {
+				columnType = valueType
+			} else if columnType != valueType {
+				columnType = "MIXED"
+				break
+			}
+		}
+		if columnType == "" {
+			columnType = "NULL"
+		}
+		columns = append(columns, fmt.Sprintf("%s (%s)", column, columnType))
+	}
+	return columns
+}
+
+func valueTypeName(value interface{}) string {
+	switch v := value.(type) {
+	case json.Number:
+		if _, err := v.Int64(); err == nil {
+			return "INTEGER"
+		}
+		return "REAL"
+	case string:
+		return "TEXT"
+	case map[string]interface{}:
+		return "BLOB"
+	default:
+		return "TEXT"
+	}
+}
+
+func formatRow(row Row) []string {
+	values := make([]string, 0, len(row))
+	for _, value := range row {
+		if value == nil {
+			if showTypesFlag {
+				values = append(values, "(null)")
+			} else {
+				values = append(values, "NULL")
+			}
+			continue
+		}
+		values = append(values, fmt.Sprint(value))
+	}
+	return values
+}
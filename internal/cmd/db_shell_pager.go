@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var noPagerFlag bool
+
+func addPagerFlag(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&noPagerFlag, "no-pager", false, "Don't pipe the results of SQL given as an argument through $PAGER. Results in the interactive shell are never paged, libsql-shell-go doesn't support it.")
+}
+
+// shouldPage reports whether results should go through a pager. Results are
+// only paged when a person is looking at them, and only for SQL given as an
+// argument, since libsql-shell-go writes the interactive results itself.
+func shouldPage() bool {
+	return !noPagerFlag && outputFileFlag == "" && isInteractive()
+}
+
+func pagerCommand() *exec.Cmd {
+	pager := strings.Fields(os.Getenv("PAGER"))
+	if len(pager) == 0 {
+		pager = []string{"less"}
+	}
+	cmd := exec.Command(pager[0], pager[1:]...)
+	if pager[0] == "less" && os.Getenv("LESS") == "" {
+		// quit if the output fits the screen, keep colors and don't clear it
+		cmd.Env = append(os.Environ(), "LESS=FRX")
+	}
+	return cmd
+}
+
+// paged runs fn with a writer whose contents are shown through the pager once
+// fn returns. If the pager can't be started, the output goes to stdout.
+func paged(fn func(w io.Writer) error) error {
+	var buf bytes.Buffer
+	err := fn(&buf)
+	if buf.Len() == 0 {
+		return err
+	}
+
+	pager := pagerCommand()
+	pager.Stdin = &buf
+	pager.Stdout = os.Stdout
+	pager.Stderr = os.Stderr
+	if perr := pager.Run(); perr != nil && buf.Len() > 0 {
+		_, _ = io.Copy(os.Stdout, &buf)
+	}
+	return err
+}
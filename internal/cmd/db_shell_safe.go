@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/spf13/cobra"
+	"github.com/tursodatabase/turso-cli/internal"
+	"golang.org/x/exp/slices"
+)
+
+var safeFlag bool
+
+var safeKeywords = []string{"SELECT", "PRAGMA", "EXPLAIN"}
+
+func addSafeFlag(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&safeFlag, "safe", false, "Refuse to run statements other than SELECT, PRAGMA and EXPLAIN. The interactive shell connects with a read-only token.")
+}
+
+// checkSafe returns an error for the first statement in sql that isn't allowed
+// by --safe.
+func checkSafe(sql string) error {
+	scanner := newStatementScanner(strings.NewReader(sql))
+	for scanner.Scan() {
+		statement := scanner.Statement()
+		if !slices.Contains(safeKeywords, leadingKeyword(statement.SQL)) {
+			return fmt.Errorf("refusing to run the statement at line %d in safe mode, only SELECT, PRAGMA and EXPLAIN statements are allowed. Run without %s to modify the database:\n%s", statement.Line, internal.Emph("--safe"), statement.SQL)
+		}
+	}
+	return scanner.Err()
+}
+
+// leadingKeyword returns the first keyword of a statement in upper case,
+// skipping whitespace and comments before it.
+func leadingKeyword(sql string) string {
+	for {
+		sql = strings.TrimLeftFunc(sql, unicode.IsSpace)
+		switch {
+		case strings.HasPrefix(sql, "--"):
+			_, rest, found := strings.Cut(sql, "\n")
+			if !found {
+				return ""
+			}
+			sql = rest
+		case strings.HasPrefix(sql, "/*"):
+			_, rest, found := strings.Cut(sql[2:], "*/")
+			if !found {
+				return ""
+			}
+			sql = rest
+		default:
+			end := strings.IndexFunc(sql, func(r rune) bool {
+				return !unicode.IsLetter(r)
+			})
+			if end == -1 {
+				end = len(sql)
+			}
+			return strings.ToUpper(sql[:end])
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestEchoRunsInOneRequest(t *testing.T) {
+	m := newMockTurso(t)
+	m.on("POST", "/", http.StatusOK, `[{"results":{"columns":[],"rows":[]}},{"results":{"columns":[],"rows":[]}},{"results":{"columns":["n"],"rows":[[1]]}},{"results":{"columns":[],"rows":[]}}]`)
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stderr := os.Stderr
+	os.Stderr = w
+	out, err := runCommand(t, m, "db", "shell", m.URL+"?authToken=secret", "BEGIN; INSERT INTO t VALUES (1); SELECT count(*) AS n FROM t; COMMIT;", "--echo")
+	os.Stderr = stderr
+	w.Close()
+	echoed, _ := io.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body, _ := m.received("POST", "/")
+	if !strings.Contains(body, "BEGIN") || !strings.Contains(body, "COMMIT") {
+		t.Errorf("expected the statements to be sent in one request, got %s", body)
+	}
+	if want := "BEGIN;\nINSERT INTO t VALUES (1);\nSELECT count(*) AS n FROM t;\nCOMMIT;\n"; string(echoed) != want {
+		t.Errorf("expected the statements echoed to stderr\n%s\ngot\n%s", want, echoed)
+	}
+	if !strings.Contains(out, "1") {
+		t.Errorf("expected the query results on stdout, got %q", out)
+	}
+}
+
+func TestTransactionFailureIsNotCommitted(t *testing.T) {
+	m := newMockTurso(t)
+	m.on("POST", "/", http.StatusOK, `[{"results":{"columns":[],"rows":[]}},{"results":{"columns":[],"rows":[]}},{"error":{"message":"UNIQUE constraint failed: t.id"}}]`)
+
+	_, err := runCommand(t, m, "db", "shell", m.URL+"?authToken=secret", "INSERT INTO t VALUES (1);\nINSERT INTO t VALUES (1);", "--transaction")
+	if err == nil || !strings.Contains(err.Error(), "line 2 failed, so the transaction was not committed: UNIQUE constraint failed") {
+		t.Fatalf("expected the failed statement to be reported, got %v", err)
+	}
+
+	body, _ := m.received("POST", "/")
+	var request struct {
+		Statements []struct {
+			SQL string `json:"q"`
+		} `json:"statements"`
+	}
+	if err := json.Unmarshal([]byte(body), &request); err != nil {
+		t.Fatal(err)
+	}
+	statements := request.Statements
+	if len(statements) != 4 || statements[0].SQL != "BEGIN" || statements[3].SQL != "COMMIT" {
+		t.Errorf("expected the statements to be wrapped in BEGIN and COMMIT, got %s", body)
+	}
+}
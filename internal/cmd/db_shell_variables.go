@@ -0,0 +1,132 @@
+package cmd
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	variableFlags  []string
+	shellVariables map[string]string
+)
+
+var variableNameRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+func addVariableFlag(cmd *cobra.Command) {
+	cmd.Flags().StringArrayVar(&variableFlags, "variable", nil, "Set a variable as name=value for SQL from arguments or stdin. Use :name to bind it as a value and :\"name\" to use it as a quoted identifier. Can be repeated.")
+}
+
+func parseVariables(flags []string) (map[string]string, error) {
+	if len(flags) == 0 {
+		return nil, nil
+	}
+	vars := make(map[string]string, len(flags))
+	for _, flag := range flags {
+		name, value, found := strings.Cut(flag, "=")
+		if !found {
+			return nil, fmt.Errorf("invalid --variable %s, expected name=value", flag)
+		}
+		if !variableNameRegex.MatchString(name) {
+			return nil, fmt.Errorf("invalid variable name %s, names must start with a letter or underscore and contain only letters, digits and underscores", name)
+		}
+		vars[name] = value
+	}
+	return vars, nil
+}
+
+// substituteVariables replaces the variables referenced in a statement.
+// :"name" is replaced by the value quoted as an identifier, and :name by a ?
+// placeholder whose value is returned as a parameter, so values are never
+// interpolated into the SQL. References inside string literals, quoted
+// identifiers and comments are left alone.
+func substituteVariables(sql string, vars map[string]string) (string, []interface{}, error) {
+	var sb strings.Builder
+	var params []interface{}
+	state := stateCode
+	runes := []rune(sql)
+	for i := 0; i < len(runes); i++ {
+		c := runes[i]
+		next := rune(0)
+		if i+1 < len(runes) {
+			next = runes[i+1]
+		}
+
+		switch state {
+		case stateCode:
+			switch {
+			case c == ':' && next == '"':
+				end := strings.IndexRune(string(runes[i+2:]), '"')
+				if end == -1 {
+					return "", nil, fmt.Errorf("unterminated variable reference :\"%s", string(runes[i+2:]))
+				}
+				name := string(runes[i+2:])[:end]
+				value, ok := vars[name]
+				if !ok {
+					return "", nil, fmt.Errorf("undefined variable %s", name)
+				}
+				sb.WriteString(quoteIdentifier(value))
+				i += 2 + len([]rune(name))
+				continue
+			case c == ':' && isVariableStart(next):
+				j := i + 1
+				for j < len(runes) && isVariablePart(runes[j]) {
+					j++
+				}
+				name := string(runes[i+1 : j])
+				value, ok := vars[name]
+				if !ok {
+					return "", nil, fmt.Errorf("undefined variable %s", name)
+				}
+				sb.WriteRune('?')
+				params = append(params, value)
+				i = j - 1
+				continue
+			case c == '-' && next == '-':
+				state = stateLineComment
+			case c == '/' && next == '*':
+				state = stateBlockComment
+				sb.WriteRune(c)
+				sb.WriteRune(next)
+				i++
+				continue
+			case c == '\'':
+				state = stateSingleQuote
+			case c == '"':
+				state = stateDoubleQuote
+			case c == '`':
+				state = stateBacktick
+			case c == '[':
+				state = stateBracket
+			}
+		case stateSingleQuote, stateDoubleQuote, stateBacktick, stateBracket:
+			if c == closingQuote[state] {
+				state = stateCode
+			}
+		case stateLineComment:
+			if c == '\n' {
+				state = stateCode
+			}
+		case stateBlockComment:
+			if c == '*' && next == '/' {
+				sb.WriteRune(c)
+				sb.WriteRune(next)
+				i++
+				state = stateCode
+				continue
+			}
+		}
+		sb.WriteRune(c)
+	}
+	return sb.String(), params, nil
+}
+
+func isVariableStart(c rune) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isVariablePart(c rune) bool {
+	return isVariableStart(c) || (c >= '0' && c <= '9')
+}
@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSubstituteVariables(t *testing.T) {
+	vars := map[string]string{"table": `my "users"`, "email": "a@b.c", "id": "42"}
+	tests := []struct {
+		name       string
+		sql        string
+		wantSQL    string
+		wantParams []interface{}
+		wantErr    bool
+	}{
+		{
+			name:       "identifier and values",
+			sql:        `SELECT * FROM :"table" WHERE email = :email AND id = :id`,
+			wantSQL:    `SELECT * FROM "my ""users""" WHERE email = ? AND id = ?`,
+			wantParams: []interface{}{"a@b.c", "42"},
+		},
+		{
+			name:    "references in literals and comments are kept",
+			sql:     "SELECT ':email', \":id\" -- :table\n/* :id */ FROM t",
+			wantSQL: "SELECT ':email', \":id\" -- :table\n/* :id */ FROM t",
+		},
+		{
+			name:    "undefined variable",
+			sql:     "SELECT :missing",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sql, params, err := substituteVariables(tt.sql, vars)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("substituteVariables() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if sql != tt.wantSQL {
+				t.Errorf("substituteVariables() sql = %q, want %q", sql, tt.wantSQL)
+			}
+			if !reflect.DeepEqual(params, tt.wantParams) {
+				t.Errorf("substituteVariables() params = %v, want %v", params, tt.wantParams)
+			}
+		})
+	}
+}
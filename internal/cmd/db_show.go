@@ -2,13 +2,16 @@ package cmd
 
 import (
 	"fmt"
+	"os"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/dustin/go-humanize"
 
 	"github.com/spf13/cobra"
 	"github.com/tursodatabase/turso-cli/internal"
+	"github.com/tursodatabase/turso-cli/internal/turso"
 )
 
 func init() {
@@ -17,16 +20,47 @@ func init() {
 	showCmd.Flags().BoolVar(&showHttpUrlFlag, "http-url", false, "Show HTTP URL for the database HTTP API.")
 	showCmd.Flags().BoolVar(&showInstanceUrlsFlag, "instance-urls", false, "Show URL for the HTTP API of all existing instances")
 	showCmd.Flags().StringVar(&showInstanceUrlFlag, "instance-url", "", "Show URL for the HTTP API of a selected instance of a database. Instance is selected by instance name.")
+	showCmd.Flags().BoolVar(&showAllInstancesFlag, "all-instances", false, "With --url, show the URL of every instance of the database, one per line.")
+	showCmd.Flags().BoolVar(&showHistoryFlag, "history", false, "Show the recent operations on the database, like creating it or adding replicas.")
+	addPlainFlag(showCmd)
+	addNoHeaderFlag(showCmd)
+	addOutputFlag(showCmd)
+	addTemplateFlags(showCmd)
+	showCmd.MarkFlagsMutuallyExclusive("plain", "output")
+	showCmd.Flags().BoolVar(&showWatchFlag, "watch", false, "Keep refreshing the database details until interrupted.")
+	showCmd.Flags().DurationVar(&showIntervalFlag, "interval", 5*time.Second, "How often to refresh the details with --watch.")
+	showCmd.MarkFlagsMutuallyExclusive("history", "url")
+	showCmd.MarkFlagsMutuallyExclusive("history", "http-url")
+	showCmd.MarkFlagsMutuallyExclusive("history", "instance-url")
+	showCmd.MarkFlagsMutuallyExclusive("history", "watch")
 	showCmd.RegisterFlagCompletionFunc("instance-url", completeInstanceName)
 	showCmd.RegisterFlagCompletionFunc("instance-ws-url", completeInstanceName)
 }
 
 var showCmd = &cobra.Command{
-	Use:               "show <database-name>",
+	Use:               "show [database-name]",
 	Short:             "Show information from a database.",
-	Args:              cobra.ExactArgs(1),
+	Long:              "Show information from a database.\nWithout a database name, the default database set with turso db config set-default is shown.",
+	Args:              cobra.MaximumNArgs(1),
 	ValidArgsFunction: dbNameArg,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if showWatchFlag && showIntervalFlag < time.Second {
+			return fmt.Errorf("--interval must be at least 1s")
+		}
+		tmpl, err := parseOutputTemplate()
+		if err != nil {
+			return err
+		}
+		if showWatchFlag && outputFlag != outputTable {
+			return fmt.Errorf("--watch can only be used with table output")
+		}
+		if showAllInstancesFlag && !showUrlFlag {
+			return fmt.Errorf("--all-instances can only be used with --url")
+		}
+		args, err = withDefaultDatabase(args)
+		if err != nil {
+			return err
+		}
 		cmd.SilenceUsage = true
 		client, err := authedTursoClient()
 		if err != nil {
@@ -37,6 +71,14 @@ var showCmd = &cobra.Command{
 			return err
 		}
 
+		if showHistoryFlag {
+			return printDatabaseHistory(client, db)
+		}
+
+		if showUrlFlag && showAllInstancesFlag {
+			return printInstanceUrls(client, db)
+		}
+
 		if showUrlFlag {
 			fmt.Println(getDatabaseUrl(&db))
 			return nil
@@ -62,49 +104,132 @@ var showCmd = &cobra.Command{
 			return fmt.Errorf("instance %s was not found for database %s. List known instances using %s", internal.Emph(showInstanceUrlFlag), internal.Emph(db.Name), internal.Emph("turso db show "+db.Name))
 		}
 
-		regions := make([]string, len(db.Regions))
-		copy(regions, db.Regions)
-		sort.Strings(regions)
-
-		headers := []string{"Name", "Type", "Location"}
-		if showInstanceUrlsFlag {
-			headers = append(headers, "URL")
+		if showWatchFlag {
+			return watchDatabase(client, db.Name)
 		}
 
-		data := [][]string{}
-		for _, instance := range instances {
-			row := []string{instance.Name, instance.Type, instance.Region}
-			if showInstanceUrlsFlag {
-				url := getInstanceUrl(&db, &instance)
-				row = append(row, url)
-			}
-			data = append(data, row)
+		if jsonOutput() {
+			info := newDatabaseInfo(db)
+			info.Instances = newInstanceInfos(db, instances)
+			return printJSON(info)
 		}
 
-		fmt.Println("Name:          ", db.Name)
-		fmt.Println("URL:           ", getDatabaseUrl(&db))
-		fmt.Println("ID:            ", db.ID)
-		if db.Group != "" {
-			fmt.Println("Group:         ", db.Group)
+		if tmpl != nil {
+			return executeTemplate(os.Stdout, tmpl, databaseTemplateData{Database: db, URL: getDatabaseUrl(&db), Instances: instances})
 		}
-		if db.Version != "" {
-			fmt.Println("Version:       ", db.Version)
+
+		printDatabaseDetails(db, instances, dbUsage)
+		return nil
+	},
+}
+
+func printInstanceUrls(client *turso.Client, db turso.Database) error {
+	instances, err := client.Instances.List(db.Name)
+	if err != nil {
+		return fmt.Errorf("could not get instances of database %s: %w", db.Name, err)
+	}
+	urls := make([]string, 0, len(instances))
+	for _, instance := range instances {
+		urls = append(urls, getInstanceUrl(&db, &instance))
+	}
+	if jsonOutput() {
+		return printJSON(urls)
+	}
+	for _, url := range urls {
+		fmt.Println(url)
+	}
+	return nil
+}
+
+func printDatabaseDetails(db turso.Database, instances []turso.Instance, dbUsage turso.DbUsage) {
+	regions := make([]string, len(db.Regions))
+	copy(regions, db.Regions)
+	sort.Strings(regions)
+
+	headers := []string{"Name", "Type", "Location"}
+	if showInstanceUrlsFlag {
+		headers = append(headers, "URL")
+	}
+
+	data := [][]string{}
+	for _, instance := range instances {
+		row := []string{instance.Name, instance.Type, instance.Region}
+		if showInstanceUrlsFlag {
+			url := getInstanceUrl(&db, &instance)
+			row = append(row, url)
 		}
-		fmt.Println("Locations:     ", strings.Join(regions, ", "))
-		fmt.Println("Size:          ", humanize.Bytes(dbUsage.Usage.StorageBytesUsed))
-		fmt.Println("Sleeping:      ", formatBool(db.Sleeping))
-		fmt.Println("Bytes Synced:  ", humanize.Bytes(dbUsage.Usage.BytesSynced))
+		data = append(data, row)
+	}
 
+	if plainFlag {
+		printTable([]string{"Field", "Value"}, databaseDetails(db, regions, dbUsage))
 		fmt.Println()
+		printTable(headers, data)
+		return
+	}
 
-		if len(instances) == 0 {
-			fmt.Printf("🛠 Run %s to finish your database creation!\n", internal.Emph("turso db replicate "+db.Name))
-			return nil
-		}
+	for _, detail := range databaseDetails(db, regions, dbUsage) {
+		fmt.Printf("%-15s %s\n", detail[0]+":", detail[1])
+	}
+
+	fmt.Println()
 
+	if len(instances) == 0 {
+		fmt.Printf("🛠 Run %s to finish your database creation!\n", internal.Emph("turso db replicate "+db.Name))
+		return
+	}
+
+	if !noHeaderFlag {
 		fmt.Print("Database Instances:\n")
-		printTable(headers, data)
+	}
+	printTable(headers, data)
+}
 
-		return nil
-	},
+func databaseDetails(db turso.Database, regions []string, dbUsage turso.DbUsage) [][]string {
+	details := [][]string{
+		{"Name", db.Name},
+		{"URL", getDatabaseUrl(&db)},
+		{"ID", db.ID},
+	}
+	if db.Group != "" {
+		details = append(details, []string{"Group", db.Group})
+	}
+	if db.Version != "" {
+		details = append(details, []string{"Version", db.Version})
+	}
+	if description := databaseDescription(db.ID); description != "" {
+		details = append(details, []string{"Description", description})
+	}
+	return append(details,
+		[]string{"Locations", strings.Join(regions, ", ")},
+		[]string{"Size", humanize.Bytes(dbUsage.Usage.StorageBytesUsed)},
+		[]string{"Sleeping", formatBool(db.Sleeping)},
+		[]string{"Bytes Synced", humanize.Bytes(dbUsage.Usage.BytesSynced)},
+	)
+}
+
+// watchDatabase redraws the details of a database every --interval until the
+// user interrupts it.
+func watchDatabase(client *turso.Client, name string) error {
+	ctx := commandContext()
+	for {
+		db, err := getDatabase(client, name, true)
+		if err != nil {
+			return err
+		}
+		instances, dbUsage, err := instancesAndUsage(client, db.Name)
+		if err != nil {
+			return fmt.Errorf("could not get instances of database %s: %w", db.Name, err)
+		}
+
+		fmt.Print("\033[H\033[2J")
+		printDatabaseDetails(db, instances, dbUsage)
+		fmt.Printf("\nRefreshing every %s, last update at %s. Press Ctrl-C to stop.\n", showIntervalFlag, time.Now().Format(time.TimeOnly))
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(showIntervalFlag):
+		}
+	}
 }
@@ -0,0 +1,101 @@
+package cmd
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/tursodatabase/turso-cli/internal"
+	"github.com/tursodatabase/turso-cli/internal/turso"
+)
+
+// historyPageSize is how many of the organization's most recent operations
+// are searched for the ones about a database.
+const historyPageSize = 100
+
+type historyEntry struct {
+	Time    string `json:"time"`
+	Event   string `json:"event"`
+	Author  string `json:"author,omitempty"`
+	Origin  string `json:"origin,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+func printDatabaseHistory(client *turso.Client, db turso.Database) error {
+	logs, err := client.Organizations.AuditLogs(historyPageSize)
+	switch turso.StatusCode(err) {
+	case 0:
+		if err != nil {
+			return err
+		}
+	case http.StatusNotFound, http.StatusForbidden, http.StatusNotImplemented:
+		if jsonOutput() {
+			return printJSON([]historyEntry{})
+		}
+		fmt.Println("No history available for this account.")
+		return nil
+	default:
+		return err
+	}
+
+	entries := []historyEntry{}
+	for _, log := range logs {
+		if !mentions(log.Data, db.Name, db.ID) {
+			continue
+		}
+		entries = append(entries, historyEntry{
+			Time:    log.CreatedAt,
+			Event:   log.Code,
+			Author:  log.Author,
+			Origin:  log.Origin,
+			Message: log.Message,
+		})
+	}
+
+	if jsonOutput() {
+		return printJSON(entries)
+	}
+	if len(entries) == 0 {
+		fmt.Printf("No recent operations found for database %s.\n", internal.Emph(db.Name))
+		return nil
+	}
+	data := make([][]string, 0, len(entries))
+	for _, entry := range entries {
+		data = append(data, []string{formatHistoryTime(entry.Time), entry.Event, entry.Author, entry.Message})
+	}
+	printTable([]string{"Time", "Event", "Author", "Message"}, data)
+	return nil
+}
+
+// mentions reports whether any string in the audit log data is one of values.
+func mentions(data interface{}, values ...string) bool {
+	switch v := data.(type) {
+	case string:
+		for _, value := range values {
+			if value != "" && v == value {
+				return true
+			}
+		}
+	case map[string]interface{}:
+		for _, item := range v {
+			if mentions(item, values...) {
+				return true
+			}
+		}
+	case []interface{}:
+		for _, item := range v {
+			if mentions(item, values...) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func formatHistoryTime(value string) string {
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return value
+	}
+	return t.Local().Format(time.DateTime)
+}
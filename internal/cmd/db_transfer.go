@@ -9,8 +9,22 @@ import (
 	"github.com/tursodatabase/turso-cli/internal/turso"
 )
 
+var transferToFlag string
+
 func init() {
 	orgCmd.AddCommand(dbTransferCmd)
+	dbCmd.AddCommand(dbTransferToCmd)
+	dbTransferToCmd.Flags().StringVar(&transferToFlag, "to", "", "Organization to transfer the database to.")
+	_ = dbTransferToCmd.MarkFlagRequired("to")
+	_ = dbTransferToCmd.RegisterFlagCompletionFunc("to", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		client, err := authedTursoClient()
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		orgs, _ := client.Organizations.List()
+		return extractOrgNames(orgs), cobra.ShellCompDirectiveNoFileComp
+	})
+	dbTransferToCmd.Flags().BoolVarP(&yesFlag, "yes", "y", false, "Confirms the transfer of the database.")
 }
 
 var dbTransferCmd = &cobra.Command{
@@ -24,42 +38,69 @@ var dbTransferCmd = &cobra.Command{
 		if err != nil {
 			return err
 		}
-		dbName := args[0]
-		orgName := args[1]
+		return confirmAndTransfer(client, args[0], args[1])
+	},
+}
 
-		if _, err := getDatabase(client, dbName, true); err != nil {
+var dbTransferToCmd = &cobra.Command{
+	Use:               "transfer <database-name> --to <organization-name>",
+	Short:             "Transfers a database to another organization",
+	Example:           "  turso db transfer my-db --to my-org",
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: dbNameArg,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+		client, err := authedTursoClient()
+		if err != nil {
 			return err
 		}
-		fmt.Printf("To transfer %s database to another organization, all its replicas must be updated.\n", internal.Emph(dbName))
-		fmt.Printf("All your active connections to the DB will be dropped and there will be a short downtime.\n\n")
+		return confirmAndTransfer(client, args[0], transferToFlag)
+	},
+}
 
-		ok, err := promptConfirmation(fmt.Sprintf("Are you sure you want to transfer database %s to organization %s?", internal.Emph(dbName), internal.Emph(orgName)))
-		if err != nil {
-			return fmt.Errorf("could not get prompt confirmed by user: %w", err)
-		}
+func confirmAndTransfer(client *turso.Client, dbName, orgName string) error {
+	db, err := getDatabase(client, dbName, true)
+	if err != nil {
+		return err
+	}
 
-		if !ok {
-			fmt.Println("Transfer database cancelled by the user.")
-			return nil
-		}
+	if yesFlag {
+		return transfer(client, db, orgName)
+	}
 
-		return transfer(client, dbName, orgName)
-	},
+	fmt.Printf("To transfer %s database to another organization, all its replicas must be updated.\n", internal.Emph(dbName))
+	fmt.Printf("All your active connections to the DB will be dropped and there will be a short downtime.\n\n")
+
+	ok, err := promptConfirmation(fmt.Sprintf("Are you sure you want to transfer database %s to organization %s?", internal.Emph(dbName), internal.Emph(orgName)))
+	if err != nil {
+		return fmt.Errorf("could not get prompt confirmed by user: %w", err)
+	}
+
+	if !ok {
+		fmt.Println("Transfer database cancelled by the user.")
+		return nil
+	}
+
+	return transfer(client, db, orgName)
 }
 
-func transfer(client *turso.Client, dbName, orgName string) error {
+func transfer(client *turso.Client, db turso.Database, orgName string) error {
 	invalidateDatabasesCache()
 
-	msg := fmt.Sprintf("Transferring database %s to organization %s", internal.Emph(dbName), internal.Emph(orgName))
+	msg := fmt.Sprintf("Transferring database %s to organization %s", internal.Emph(db.Name), internal.Emph(orgName))
 	s := prompt.Spinner(msg)
 	defer s.Stop()
 
-	if err := client.Databases.Transfer(dbName, orgName); err != nil {
+	if err := client.Databases.Transfer(db.Name, orgName); err != nil {
 		return err
 	}
 
+	// the database belongs to another organization now, so tokens minted
+	// for it from this one must not be reused
+	clearDBTokenCache(db.ID)
+
 	s.Stop()
-	fmt.Printf("✔  Success! Database %s transferred successfully to organization %s\n", internal.Emph(dbName), internal.Emph(orgName))
+	fmt.Printf("✔  Success! Database %s transferred successfully to organization %s\n", internal.Emph(db.Name), internal.Emph(orgName))
 
 	return nil
 }
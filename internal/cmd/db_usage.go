@@ -0,0 +1,162 @@
+package cmd
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+	"github.com/tursodatabase/turso-cli/internal/turso"
+)
+
+var usageDBFlag string
+
+func init() {
+	dbCmd.AddCommand(usageCmd)
+	usageCmd.Flags().StringVar(&usageDBFlag, "db", "", "Only show the usage of this database.")
+	usageCmd.RegisterFlagCompletionFunc("db", dbNameArg)
+	addOutputFlag(usageCmd)
+}
+
+type usageInfo struct {
+	Name         string `json:"name,omitempty"`
+	ID           string `json:"id,omitempty"`
+	RowsRead     uint64 `json:"rows_read"`
+	RowsWritten  uint64 `json:"rows_written"`
+	StorageBytes uint64 `json:"storage_bytes"`
+	BytesSynced  uint64 `json:"bytes_synced"`
+}
+
+type orgUsageInfo struct {
+	Total     usageInfo   `json:"total"`
+	Databases []usageInfo `json:"databases"`
+}
+
+var usageCmd = &cobra.Command{
+	Use:               "usage",
+	Short:             "Show the rows read and written, storage and syncs used in the current billing period.",
+	Example:           "  turso db usage\n  turso db usage --db name-of-my-amazing-db --output json",
+	Args:              cobra.NoArgs,
+	ValidArgsFunction: noFilesArg,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := validateOutputFlag(); err != nil {
+			return err
+		}
+		cmd.SilenceUsage = true
+
+		client, err := authedTursoClient()
+		if err != nil {
+			return err
+		}
+
+		if usageDBFlag != "" {
+			return printDatabaseUsage(client, usageDBFlag)
+		}
+		return printOrgUsage(client)
+	},
+}
+
+func printDatabaseUsage(client *turso.Client, name string) error {
+	db, err := getDatabase(client, name)
+	if err != nil {
+		return err
+	}
+
+	usage, err := client.Databases.Usage(db.Name)
+	if err != nil {
+		return usageError(err)
+	}
+
+	info := newUsageInfo(db.Name, db.ID, usage.Usage)
+	if jsonOutput() {
+		return printJSON(info)
+	}
+	printUsageTable([]usageInfo{info}, nil)
+	return nil
+}
+
+func printOrgUsage(client *turso.Client) error {
+	usage, err := client.Organizations.Usage()
+	if err != nil {
+		return usageError(err)
+	}
+
+	names := map[string]string{}
+	if databases, err := getDatabases(client); err == nil {
+		for _, db := range databases {
+			names[db.ID] = db.Name
+		}
+	}
+
+	info := orgUsageInfo{
+		Total: usageInfo{
+			RowsRead:     usage.Usage.RowsRead,
+			RowsWritten:  usage.Usage.RowsWritten,
+			StorageBytes: usage.Usage.StorageBytesUsed,
+			BytesSynced:  usage.Usage.BytesSynced,
+		},
+		Databases: make([]usageInfo, 0, len(usage.Databases)),
+	}
+	for _, db := range usage.Databases {
+		info.Databases = append(info.Databases, newUsageInfo(names[db.UUID], db.UUID, db.Usage))
+	}
+	sort.Slice(info.Databases, func(i, j int) bool {
+		return info.Databases[i].Name < info.Databases[j].Name
+	})
+
+	if jsonOutput() {
+		return printJSON(info)
+	}
+	printUsageTable(info.Databases, &info.Total)
+	return nil
+}
+
+func newUsageInfo(name, id string, usage turso.Usage) usageInfo {
+	return usageInfo{
+		Name:         name,
+		ID:           id,
+		RowsRead:     usage.RowsRead,
+		RowsWritten:  usage.RowsWritten,
+		StorageBytes: usage.StorageBytesUsed,
+		BytesSynced:  usage.BytesSynced,
+	}
+}
+
+func printUsageTable(databases []usageInfo, total *usageInfo) {
+	data := make([][]string, 0, len(databases)+1)
+	for _, db := range databases {
+		name := db.Name
+		if name == "" {
+			// usage is reported for databases that were destroyed since
+			name = db.ID + " (destroyed)"
+		}
+		data = append(data, usageRow(name, db))
+	}
+	if total != nil {
+		data = append(data, usageRow("Total", *total))
+	}
+	printTable([]string{"Database", "Rows Read", "Rows Written", "Storage", "Embedded Syncs"}, data)
+}
+
+func usageRow(name string, usage usageInfo) []string {
+	return []string{
+		name,
+		strconv.FormatUint(usage.RowsRead, 10),
+		strconv.FormatUint(usage.RowsWritten, 10),
+		humanize.Bytes(usage.StorageBytes),
+		humanize.Bytes(usage.BytesSynced),
+	}
+}
+
+// usageError explains failures caused by the usage endpoints not being
+// available to the account, rather than by a problem with the request.
+func usageError(err error) error {
+	switch turso.StatusCode(err) {
+	case http.StatusNotFound, http.StatusForbidden, http.StatusNotImplemented:
+		return fmt.Errorf("usage information is not available for this account: %w", err)
+	default:
+		return err
+	}
+}
@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/tursodatabase/turso-cli/internal"
+	"github.com/tursodatabase/turso-cli/internal/prompt"
+	"github.com/tursodatabase/turso-cli/internal/turso"
+)
+
+var waitTimeoutFlag time.Duration
+
+func init() {
+	dbCmd.AddCommand(dbWaitCmd)
+	addLocationFlag(dbWaitCmd, "Only wait for the instances in this location.")
+	dbWaitCmd.Flags().DurationVar(&waitTimeoutFlag, "timeout", 10*time.Minute, "Maximum time to wait for the instances to be ready.")
+}
+
+var dbWaitCmd = &cobra.Command{
+	Use:               "wait <database-name>",
+	Short:             "Wait until the instances of a database are ready to answer queries.",
+	Long:              "Wait until the instances of a database are ready to answer queries.\n\nExits with status 124 if they are not ready after --timeout.",
+	Example:           "  turso db wait my-db\n  turso db wait my-db --location ams --timeout 2m",
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: dbNameArg,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if waitTimeoutFlag <= 0 {
+			return fmt.Errorf("--timeout must be positive")
+		}
+		cmd.SilenceUsage = true
+		client, err := authedTursoClient()
+		if err != nil {
+			return err
+		}
+		db, err := getDatabase(client, args[0], true)
+		if err != nil {
+			return err
+		}
+		instances, err := instancesToWaitFor(client, db, locationFlag)
+		if err != nil {
+			return err
+		}
+		token, err := tokenFromDb(&db, client, nil)
+		if err != nil {
+			return err
+		}
+
+		start := time.Now()
+		ctx, cancel := context.WithTimeout(commandContext(), waitTimeoutFlag)
+		defer cancel()
+		s := prompt.Spinner("")
+		defer s.Stop()
+		for _, instance := range instances {
+			s.Text(fmt.Sprintf("Waiting for instance %s of %s to be ready...", internal.Emph(instance.Name), internal.Emph(db.Name)))
+			last, err := waitUntilReady(ctx, getUrl(&db, &instance, "https"), token)
+			if err != nil {
+				s.Stop()
+				if last != "" {
+					return fmt.Errorf("instance %s of database %s was not ready after %s: %w\nLast error: %s", instance.Name, db.Name, waitTimeoutFlag, err, last)
+				}
+				return fmt.Errorf("instance %s of database %s was not ready after %s: %w", instance.Name, db.Name, waitTimeoutFlag, err)
+			}
+		}
+		s.Stop()
+
+		if locationFlag != "" {
+			fmt.Printf("Database %s is ready at %s after %s.\n", internal.Emph(db.Name), internal.Emph(locationFlag), time.Since(start).Round(time.Millisecond))
+			return nil
+		}
+		fmt.Printf("Database %s is ready after %s.\n", internal.Emph(db.Name), time.Since(start).Round(time.Millisecond))
+		return nil
+	},
+}
+
+// instancesToWaitFor returns the instances of db, or only those in location
+// when it is set.
+func instancesToWaitFor(client *turso.Client, db turso.Database, location string) ([]turso.Instance, error) {
+	instances, err := client.Instances.List(db.Name)
+	if err != nil {
+		return nil, fmt.Errorf("could not get instances of database %s: %w", db.Name, err)
+	}
+	if location == "" {
+		if len(instances) == 0 {
+			return nil, fmt.Errorf("database %s has no instances", db.Name)
+		}
+		return instances, nil
+	}
+
+	var selected []turso.Instance
+	for _, instance := range instances {
+		if instance.Region == location {
+			selected = append(selected, instance)
+		}
+	}
+	if len(selected) == 0 {
+		if err := checkDatabaseLocation(db, location); err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("database %s has no instances in location %s yet", internal.Emph(db.Name), internal.Emph(location))
+	}
+	return selected, nil
+}
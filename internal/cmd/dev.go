@@ -56,7 +56,11 @@ var devCmd = &cobra.Command{
 		}
 
 		if devFile != "" {
-			absDevFile, err := filepath.Abs(devFile)
+			path, err := resolvePath(devFile)
+			if err != nil {
+				return err
+			}
+			absDevFile, err := filepath.Abs(path)
 			if err != nil {
 				return fmt.Errorf("Error getting absolute path: %w", err)
 			}
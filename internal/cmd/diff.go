@@ -0,0 +1,126 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+)
+
+const diffContext = 3
+
+type diffOp struct {
+	kind byte // ' ', '-' or '+'
+	line string
+}
+
+// diffLines returns the edit script that turns a into b, based on their
+// longest common subsequence.
+func diffLines(a, b []string) []diffOp {
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	ops := make([]diffOp, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			ops = append(ops, diffOp{' ', a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			ops = append(ops, diffOp{'-', a[i]})
+			i++
+		default:
+			ops = append(ops, diffOp{'+', b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		ops = append(ops, diffOp{'-', a[i]})
+	}
+	for ; j < len(b); j++ {
+		ops = append(ops, diffOp{'+', b[j]})
+	}
+	return ops
+}
+
+// unifiedDiff formats the differences between a and b in the unified diff
+// format. It returns an empty string if they are equal.
+func unifiedDiff(nameA, nameB string, a, b []string) string {
+	ops := diffLines(a, b)
+
+	var sb strings.Builder
+	for start := 0; start < len(ops); {
+		if ops[start].kind == ' ' {
+			start++
+			continue
+		}
+
+		// a hunk spans changes separated by at most 2*diffContext equal lines
+		first := max(start-diffContext, 0)
+		end := start
+		for k := start; k < len(ops); k++ {
+			if ops[k].kind != ' ' {
+				end = k + 1
+			} else if k-end >= 2*diffContext {
+				break
+			}
+		}
+		last := min(end+diffContext, len(ops))
+
+		if sb.Len() == 0 {
+			fmt.Fprintf(&sb, "--- %s\n+++ %s\n", nameA, nameB)
+		}
+		lineA, lineB := hunkStart(ops, first)
+		countA, countB := 0, 0
+		for _, op := range ops[first:last] {
+			if op.kind != '+' {
+				countA++
+			}
+			if op.kind != '-' {
+				countB++
+			}
+		}
+		fmt.Fprintf(&sb, "@@ -%s +%s @@\n", hunkRange(lineA, countA), hunkRange(lineB, countB))
+		for _, op := range ops[first:last] {
+			fmt.Fprintf(&sb, "%c%s\n", op.kind, op.line)
+		}
+		start = last
+	}
+	return sb.String()
+}
+
+// hunkStart returns the 1-based line numbers in a and b of the operation at
+// index i.
+func hunkStart(ops []diffOp, i int) (int, int) {
+	lineA, lineB := 1, 1
+	for _, op := range ops[:i] {
+		if op.kind != '+' {
+			lineA++
+		}
+		if op.kind != '-' {
+			lineB++
+		}
+	}
+	return lineA, lineB
+}
+
+func hunkRange(start, count int) string {
+	if count == 0 {
+		return fmt.Sprintf("%d,0", start-1)
+	}
+	if count == 1 {
+		return fmt.Sprint(start)
+	}
+	return fmt.Sprintf("%d,%d", start, count)
+}
@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestUnifiedDiff(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b []string
+		want string
+	}{
+		{
+			name: "equal",
+			a:    []string{"CREATE TABLE a (x);", "CREATE TABLE b (y);"},
+			b:    []string{"CREATE TABLE a (x);", "CREATE TABLE b (y);"},
+			want: "",
+		},
+		{
+			name: "changed line",
+			a:    []string{"CREATE TABLE a (x);", "CREATE TABLE b (y);"},
+			b:    []string{"CREATE TABLE a (x);", "CREATE TABLE b (y, z);"},
+			want: "--- one\n+++ two\n@@ -1,2 +1,2 @@\n CREATE TABLE a (x);\n-CREATE TABLE b (y);\n+CREATE TABLE b (y, z);\n",
+		},
+		{
+			name: "added to empty",
+			a:    nil,
+			b:    []string{"CREATE TABLE a (x);"},
+			want: "--- one\n+++ two\n@@ -0,0 +1 @@\n+CREATE TABLE a (x);\n",
+		},
+		{
+			name: "separate hunks",
+			a:    []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10"},
+			b:    []string{"one", "2", "3", "4", "5", "6", "7", "8", "9", "ten"},
+			want: "--- one\n+++ two\n@@ -1,4 +1,4 @@\n-1\n+one\n 2\n 3\n 4\n@@ -7,4 +7,4 @@\n 7\n 8\n 9\n-10\n+ten\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := unifiedDiff("one", "two", tt.a, tt.b); got != tt.want {
+				t.Errorf("unifiedDiff() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiffExitCode(t *testing.T) {
+	if code := diffExitCode(fmt.Errorf("%w: a and b", errDatabasesDiffer)); code != 1 {
+		t.Errorf("expected differences to exit with 1, got %d", code)
+	}
+	if code := diffExitCode(errors.New("database a not found")); code != 2 {
+		t.Errorf("expected errors to exit with 2, got %d", code)
+	}
+}
@@ -0,0 +1,9 @@
+package cmd
+
+import "github.com/spf13/cobra"
+
+var forceFlag bool
+
+func addForceFlag(cmd *cobra.Command, desc string) {
+	cmd.Flags().BoolVarP(&forceFlag, "force", "f", false, desc)
+}
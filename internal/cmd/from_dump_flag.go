@@ -10,5 +10,5 @@ func addDbFromDumpFlag(cmd *cobra.Command) {
 }
 
 func addDbFromDumpURLFlag(cmd *cobra.Command) {
-	cmd.Flags().StringVar(&fromDumpURLFlag, "from-dump-url", "", "create the database from a remote SQLite dump")
+	cmd.Flags().StringVar(&fromDumpURLFlag, "from-dump-url", "", "create the database from a remote SQLite dump, fetched by Turso. Use --from-url for URLs only reachable from this machine")
 }
@@ -0,0 +1,122 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+	"github.com/tursodatabase/turso-cli/internal"
+	"github.com/tursodatabase/turso-cli/internal/turso"
+)
+
+var fromURLFlag string
+
+// addDbFromURLFlag adds --from-url. Unlike --from-dump-url, which hands the URL
+// to the platform to fetch while it creates the database, the dump is
+// downloaded by the CLI, so it works with URLs that only this machine can
+// reach, and progress and --timeout apply.
+func addDbFromURLFlag(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&fromURLFlag, "from-url", "", "Download a SQL dump over HTTP from this machine and apply it once the database is ready. Unlike --from-dump-url, the URL doesn't have to be reachable by Turso, and progress is shown")
+}
+
+func validateFromURL(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return fmt.Errorf("invalid --from-url %s: %w", raw, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("invalid --from-url %s: only http and https URLs are supported", raw)
+	}
+	if u.Host == "" {
+		return fmt.Errorf("invalid --from-url %s: missing host", raw)
+	}
+	return nil
+}
+
+// seedFromURL streams the dump at rawURL into db, executing its statements in
+// batches as they are downloaded. Progress is reported through status.
+func seedFromURL(client *turso.Client, db turso.Database, rawURL string, status func(string)) error {
+	ctx, cancel := context.WithTimeout(commandContext(), createTimeoutFlag)
+	defer cancel()
+
+	token, err := tokenFromDb(&db, client, nil)
+	if err != nil {
+		return err
+	}
+	dbURL := getDatabaseHttpUrl(&db)
+
+	status(fmt.Sprintf("Waiting for database %s to be ready...", internal.Emph(db.Name)))
+	if _, err := waitUntilReady(ctx, dbURL, token); err != nil {
+		return fmt.Errorf("database %s was not ready after %s: %w", db.Name, createTimeoutFlag, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return err
+	}
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("could not download %s: %w", rawURL, err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("could not download %s: %s", rawURL, res.Status)
+	}
+
+	body := &countingReader{r: res.Body}
+	progress := func(count int) {
+		downloaded := humanize.Bytes(uint64(body.n))
+		if res.ContentLength > 0 {
+			downloaded += " of " + humanize.Bytes(uint64(res.ContentLength))
+		}
+		status(fmt.Sprintf("Seeding database %s... %s downloaded, %d statements executed", internal.Emph(db.Name), downloaded, count))
+	}
+
+	count := 0
+	batch := make([]sqlStatement, 0, restoreBatchSize)
+	flush := func() error {
+		if err := executeBatch(dbURL, token, batch); err != nil {
+			return err
+		}
+		count += len(batch)
+		batch = batch[:0]
+		progress(count)
+		return nil
+	}
+
+	scanner := newStatementScanner(body)
+	for scanner.Scan() {
+		stmt := scanner.Statement()
+		if dumpTransactionRegex.MatchString(stmt.SQL) {
+			continue
+		}
+		batch = append(batch, stmt)
+		if len(batch) == restoreBatchSize {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		if ctx.Err() != nil {
+			return fmt.Errorf("%w after %s while downloading %s", errTimeout, createTimeoutFlag, rawURL)
+		}
+		return fmt.Errorf("could not download %s: %w", rawURL, err)
+	}
+	return flush()
+}
+
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
@@ -2,6 +2,8 @@ package cmd
 
 import (
 	"fmt"
+	"io"
+	"os"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -88,19 +90,24 @@ var groupsCreateCmd = &cobra.Command{
 
 		location := locationFlag
 		if location == "" {
-			location, _ = closestLocation(client)
+			if location, err = closestLocation(client); err != nil {
+				warnf("could not find the closest location, using %s: %v", location, err)
+			}
 		}
 		if !isValidLocation(client, location) {
-			return fmt.Errorf("location '%s' is not a valid one", location)
+			return invalidLocationError(client, location)
 		}
 
-		version := "latest"
-		if canaryFlag {
-			version = "canary"
+		version, err := imageFromFlags()
+		if err != nil {
+			return err
+		}
+		if version == "" {
+			version = "latest"
 		}
 
 		name := args[0]
-		return createGroup(client, name, location, version)
+		return createGroup(client, name, location, version, os.Stdout)
 	},
 }
 
@@ -154,7 +161,8 @@ var groupsDestroyCmd = &cobra.Command{
 	},
 }
 
-func createGroup(client *turso.Client, name, location, version string) error {
+// createGroup creates a group and writes a summary to w once it is created.
+func createGroup(client *turso.Client, name, location, version string, w io.Writer) error {
 	start := time.Now()
 	description := fmt.Sprintf("Creating group %s at %s...", internal.Emph(name), internal.Emph(location))
 	spinner := prompt.Spinner(description)
@@ -171,7 +179,7 @@ func createGroup(client *turso.Client, name, location, version string) error {
 
 	spinner.Stop()
 	elapsed := time.Since(start)
-	fmt.Printf("Created group %s at %s in %s.\n", internal.Emph(name), internal.Emph(location), elapsed.Round(time.Millisecond).String())
+	fmt.Fprintf(w, "Created group %s at %s in %s.\n", internal.Emph(name), internal.Emph(location), elapsed.Round(time.Millisecond).String())
 
 	return nil
 }
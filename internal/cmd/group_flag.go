@@ -82,11 +82,19 @@ func parseDBSeedFlags(client *turso.Client) (*turso.DBSeed, error) {
 	}
 
 	if fromFileFlag != "" {
-		return handleDBFile(client, fromFileFlag)
+		path, err := resolvePath(fromFileFlag)
+		if err != nil {
+			return nil, err
+		}
+		return handleDBFile(client, path)
 	}
 
 	if fromDumpFlag != "" {
-		return handleDumpFile(client, fromDumpFlag)
+		path, err := resolvePath(fromDumpFlag)
+		if err != nil {
+			return nil, err
+		}
+		return handleDumpFile(client, path)
 	}
 
 	if fromCSVFlag != "" {
@@ -94,7 +102,11 @@ func parseDBSeedFlags(client *turso.Client) (*turso.DBSeed, error) {
 		if err != nil {
 			return nil, err
 		}
-		return handleCSVFile(client, fromCSVFlag, csvTableNameFlag, csvSeparator)
+		path, err := resolvePath(fromCSVFlag)
+		if err != nil {
+			return nil, err
+		}
+		return handleCSVFile(client, path, csvTableNameFlag, csvSeparator)
 	}
 	if fromDumpURLFlag != "" {
 		return handleDumpURL(fromDumpURLFlag)
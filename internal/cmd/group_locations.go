@@ -79,7 +79,7 @@ var groupLocationAddCmd = &cobra.Command{
 		locations := args[1:]
 		for _, location := range locations {
 			if !isValidLocation(client, location) {
-				return fmt.Errorf("location '%s' is not a valid one", location)
+				return invalidLocationError(client, location)
 			}
 			if alreadyExistingLocations[location] {
 				return fmt.Errorf("location '%s' is already part of group '%s'", location, groupName)
@@ -142,7 +142,7 @@ var groupsLocationsRmCmd = &cobra.Command{
 		locations := args[1:]
 		for _, location := range locations {
 			if !isValidLocation(client, location) {
-				return fmt.Errorf("location '%s' is not a valid one", location)
+				return invalidLocationError(client, location)
 			}
 			if group.Primary == location {
 				return fmt.Errorf("cannot remove primary location '%s' from group '%s'", location, groupName)
@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"time"
+)
+
+// exitCodeInterrupted is the conventional exit code of a process stopped with
+// Ctrl-C.
+const exitCodeInterrupted = 130
+
+// interruptGracePeriod is how long a command has to return after its context
+// is cancelled before the process exits anyway.
+const interruptGracePeriod = 3 * time.Second
+
+// handleInterrupts cancels the command context on the first Ctrl-C, so that
+// in-flight requests are aborted and deferred cleanups like stopping spinners
+// run. If the command doesn't return in time, or Ctrl-C is pressed again, the
+// process exits right away.
+func handleInterrupts(cancel context.CancelFunc) {
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, os.Interrupt)
+	go func() {
+		<-ch
+		cancel()
+		select {
+		case <-ch:
+		case <-time.After(interruptGracePeriod):
+		}
+		fmt.Fprintln(os.Stderr, "\nAborted.")
+		os.Exit(exitCodeInterrupted)
+	}()
+}
+
+// releaseInterrupts restores the default Ctrl-C handling, for commands like
+// the interactive shell that handle it themselves.
+func releaseInterrupts() {
+	signal.Reset(os.Interrupt)
+}
+
+// commandContext returns the context of the running command, which is
+// cancelled when the user presses Ctrl-C.
+func commandContext() context.Context {
+	if ctx := rootCmd.Context(); ctx != nil {
+		return ctx
+	}
+	return context.Background()
+}
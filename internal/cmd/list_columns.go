@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/exp/slices"
+)
+
+var listColumnsFlag string
+
+// parseListColumns returns the headers of the db list table named in a
+// comma-separated list, case insensitively and in the order given.
+func parseListColumns(list string, headers []string) ([]string, error) {
+	if list == "" {
+		return nil, nil
+	}
+	var columns []string
+	for _, name := range strings.Split(list, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		i := slices.IndexFunc(headers, func(header string) bool {
+			return strings.EqualFold(header, name)
+		})
+		if i == -1 {
+			valid := make([]string, 0, len(headers))
+			for _, header := range headers {
+				valid = append(valid, strings.ToLower(header))
+			}
+			return nil, fmt.Errorf("unknown column %s. Valid columns are: %s", name, strings.Join(valid, ", "))
+		}
+		if !slices.Contains(columns, headers[i]) {
+			columns = append(columns, headers[i])
+		}
+	}
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("--columns needs at least one column")
+	}
+	return columns, nil
+}
+
+// selectColumns returns the given columns of a table, in the order of columns.
+func selectColumns(headers []string, data [][]string, columns []string) ([]string, [][]string) {
+	indexes := make([]int, 0, len(columns))
+	for _, column := range columns {
+		indexes = append(indexes, slices.Index(headers, column))
+	}
+	selected := make([][]string, 0, len(data))
+	for _, row := range data {
+		cells := make([]string, 0, len(indexes))
+		for _, i := range indexes {
+			cells = append(cells, row[i])
+		}
+		selected = append(selected, cells)
+	}
+	return slices.Clone(columns), selected
+}
@@ -0,0 +1,33 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestListColumns(t *testing.T) {
+	headers := []string{"Name", "Locations", "Replicas", "URL"}
+	data := [][]string{{"db1", "ams", "1", "libsql://db1"}, {"db2", "ams, gru", "2", "libsql://db2"}}
+
+	columns, err := parseListColumns("url, NAME,url", headers)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(columns, []string{"URL", "Name"}) {
+		t.Fatalf("unexpected columns %v", columns)
+	}
+	gotHeaders, gotData := selectColumns(headers, data, columns)
+	if !reflect.DeepEqual(gotHeaders, []string{"URL", "Name"}) {
+		t.Errorf("unexpected headers %v", gotHeaders)
+	}
+	if expected := [][]string{{"libsql://db1", "db1"}, {"libsql://db2", "db2"}}; !reflect.DeepEqual(gotData, expected) {
+		t.Errorf("expected %v, got %v", expected, gotData)
+	}
+
+	if _, err := parseListColumns("name,size", headers); err == nil {
+		t.Error("expected an unknown column to fail")
+	}
+	if _, err := parseListColumns(" , ", headers); err == nil {
+		t.Error("expected an empty list of columns to fail")
+	}
+}
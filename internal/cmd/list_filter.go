@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/tursodatabase/turso-cli/internal/turso"
+)
+
+var (
+	listFilterFlag string
+	listRegexFlag  string
+)
+
+// parseNameFilter returns a function matching database names against the
+// --filter or --regex patterns. A --filter pattern, optionally prefixed with
+// name~, is a glob if it has any of *?[ and a substring otherwise.
+func parseNameFilter(filter, expr string) (func(name string) bool, error) {
+	if expr != "" {
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --regex %q: %w", expr, err)
+		}
+		return re.MatchString, nil
+	}
+
+	pattern := strings.TrimPrefix(filter, "name~")
+	if pattern == "" {
+		return nil, nil
+	}
+	if !strings.ContainsAny(pattern, "*?[") {
+		return func(name string) bool { return strings.Contains(name, pattern) }, nil
+	}
+	if _, err := path.Match(pattern, ""); err != nil {
+		return nil, fmt.Errorf("invalid --filter %q: %w", filter, err)
+	}
+	return func(name string) bool {
+		ok, _ := path.Match(pattern, name)
+		return ok
+	}, nil
+}
+
+func filterDatabases(databases []turso.Database, match func(name string) bool) []turso.Database {
+	if match == nil {
+		return databases
+	}
+	filtered := make([]turso.Database, 0, len(databases))
+	for _, database := range databases {
+		if match(database.Name) {
+			filtered = append(filtered, database)
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,47 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/tursodatabase/turso-cli/internal/turso"
+)
+
+func TestFilterDatabases(t *testing.T) {
+	databases := []turso.Database{{Name: "prod-users"}, {Name: "prod-orders"}, {Name: "staging-users"}}
+	tests := []struct {
+		filter, regex string
+		expected      []string
+	}{
+		{"", "", []string{"prod-users", "prod-orders", "staging-users"}},
+		{"prod-*", "", []string{"prod-users", "prod-orders"}},
+		{"name~prod-*", "", []string{"prod-users", "prod-orders"}},
+		{"users", "", []string{"prod-users", "staging-users"}},
+		{"*-user?", "", []string{"prod-users", "staging-users"}},
+		{"", "^(staging|prod)-o", []string{"prod-orders"}},
+	}
+	for _, test := range tests {
+		match, err := parseNameFilter(test.filter, test.regex)
+		if err != nil {
+			t.Fatalf("%q %q: %v", test.filter, test.regex, err)
+		}
+		var names []string
+		for _, database := range filterDatabases(databases, match) {
+			names = append(names, database.Name)
+		}
+		if len(names) != len(test.expected) {
+			t.Fatalf("%q %q: expected %v, got %v", test.filter, test.regex, test.expected, names)
+		}
+		for i := range names {
+			if names[i] != test.expected[i] {
+				t.Fatalf("%q %q: expected %v, got %v", test.filter, test.regex, test.expected, names)
+			}
+		}
+	}
+
+	if _, err := parseNameFilter("prod-[", ""); err == nil {
+		t.Error("expected an error for a bad glob")
+	}
+	if _, err := parseNameFilter("", "prod-("); err == nil {
+		t.Error("expected an error for a bad regex")
+	}
+}
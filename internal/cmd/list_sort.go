@@ -0,0 +1,94 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/tursodatabase/turso-cli/internal/turso"
+	"golang.org/x/exp/maps"
+)
+
+var (
+	listSortFlag string
+	listDescFlag bool
+)
+
+// databaseSortKeys compares two databases by one of the fields shown by db
+// list, returning a negative number if a comes first.
+var databaseSortKeys = map[string]func(a, b turso.Database) int{
+	"name":             func(a, b turso.Database) int { return strings.Compare(a.Name, b.Name) },
+	"id":               func(a, b turso.Database) int { return strings.Compare(a.ID, b.ID) },
+	"hostname":         func(a, b turso.Database) int { return strings.Compare(a.Hostname, b.Hostname) },
+	"primary_location": func(a, b turso.Database) int { return strings.Compare(a.PrimaryRegion, b.PrimaryRegion) },
+	"replicas":         func(a, b turso.Database) int { return len(a.Regions) - len(b.Regions) },
+	"group":            func(a, b turso.Database) int { return strings.Compare(a.Group, b.Group) },
+	"version":          func(a, b turso.Database) int { return strings.Compare(a.Version, b.Version) },
+	"sleeping": func(a, b turso.Database) int {
+		if a.Sleeping == b.Sleeping {
+			return 0
+		}
+		if a.Sleeping {
+			return 1
+		}
+		return -1
+	},
+}
+
+type sortKey struct {
+	field string
+	desc  bool
+}
+
+// parseSortKeys parses a comma-separated list of fields, each optionally
+// followed by :asc or :desc. With reverse, the direction of every key is
+// flipped.
+func parseSortKeys(list string, reverse bool) ([]sortKey, error) {
+	if list == "" {
+		list = "name"
+	}
+	var keys []sortKey
+	for _, item := range strings.Split(list, ",") {
+		field, direction, _ := strings.Cut(strings.TrimSpace(item), ":")
+		if field == "" {
+			continue
+		}
+		if _, ok := databaseSortKeys[field]; !ok {
+			fields := maps.Keys(databaseSortKeys)
+			sort.Strings(fields)
+			return nil, fmt.Errorf("unknown sort field %s. Valid fields are: %s", field, strings.Join(fields, ", "))
+		}
+		key := sortKey{field: field}
+		switch direction {
+		case "", "asc":
+		case "desc":
+			key.desc = true
+		default:
+			return nil, fmt.Errorf("invalid sort direction %s for field %s. Use asc or desc", direction, field)
+		}
+		key.desc = key.desc != reverse
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// sortDatabases sorts databases by keys. Ties are broken by name and then by
+// ID, so the order is the same on every run.
+func sortDatabases(databases []turso.Database, keys []sortKey) {
+	sort.SliceStable(databases, func(i, j int) bool {
+		a, b := databases[i], databases[j]
+		for _, key := range keys {
+			c := databaseSortKeys[key.field](a, b)
+			if key.desc {
+				c = -c
+			}
+			if c != 0 {
+				return c < 0
+			}
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.ID < b.ID
+	})
+}
@@ -0,0 +1,48 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/tursodatabase/turso-cli/internal/turso"
+)
+
+func TestSortDatabases(t *testing.T) {
+	databases := func() []turso.Database {
+		return []turso.Database{
+			{ID: "2", Name: "b", Regions: []string{"ams"}, Group: "default"},
+			{ID: "1", Name: "a", Regions: []string{"ams", "gru"}, Group: "default"},
+			{ID: "3", Name: "c", Regions: []string{"ams", "gru"}, Group: "eu"},
+		}
+	}
+	tests := []struct {
+		sort     string
+		desc     bool
+		expected []string
+	}{
+		{sort: "", expected: []string{"a", "b", "c"}},
+		{sort: "name", desc: true, expected: []string{"c", "b", "a"}},
+		{sort: "replicas:desc", expected: []string{"a", "c", "b"}},
+		{sort: "replicas", desc: true, expected: []string{"a", "c", "b"}},
+		{sort: "group:desc,replicas", expected: []string{"c", "b", "a"}},
+	}
+	for _, tt := range tests {
+		keys, err := parseSortKeys(tt.sort, tt.desc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		dbs := databases()
+		sortDatabases(dbs, keys)
+		for i, name := range tt.expected {
+			if dbs[i].Name != name {
+				t.Fatalf("--sort %q --desc=%v: expected %v, got %v", tt.sort, tt.desc, tt.expected, dbs)
+			}
+		}
+	}
+
+	if _, err := parseSortKeys("size", false); err == nil {
+		t.Fatal("expected an error for an unknown field")
+	}
+	if _, err := parseSortKeys("name:up", false); err == nil {
+		t.Fatal("expected an error for an invalid direction")
+	}
+}
@@ -1,6 +1,8 @@
 package cmd
 
 import (
+	"sort"
+
 	"github.com/spf13/cobra"
 	"golang.org/x/exp/maps"
 )
@@ -10,11 +12,26 @@ var locationFlag string
 func addLocationFlag(cmd *cobra.Command, desc string) {
 	cmd.Flags().StringVar(&locationFlag, "location", "", desc)
 	cmd.RegisterFlagCompletionFunc("location", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return completeLocationIDs(), cobra.ShellCompDirectiveNoFileComp
+	})
+}
+
+// completeLocationIDs returns the location IDs to complete, sorted. The
+// cached list is used without authenticating, so Tab doesn't wait for the
+// API unless the cache is empty or expired.
+func completeLocationIDs() []string {
+	locations := locationsCache()
+	if locations == nil {
 		client, err := authedTursoClient()
 		if err != nil {
-			return nil, cobra.ShellCompDirectiveNoFileComp
+			return nil
 		}
-		locations, _ := locations(client)
-		return maps.Keys(locations), cobra.ShellCompDirectiveNoFileComp
-	})
+		if locations, err = client.Locations.List(); err != nil {
+			return nil
+		}
+		setLocationsCache(locations)
+	}
+	ids := maps.Keys(locations)
+	sort.Strings(ids)
+	return ids
 }
@@ -0,0 +1,54 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/tursodatabase/turso-cli/internal"
+	"github.com/tursodatabase/turso-cli/internal/settings"
+	"github.com/tursodatabase/turso-cli/internal/turso"
+	"golang.org/x/exp/slices"
+)
+
+var locationGroupFlag string
+
+func addLocationGroupFlag(cmd *cobra.Command, desc string) {
+	cmd.Flags().StringVar(&locationGroupFlag, "location-group", "", desc)
+	cmd.RegisterFlagCompletionFunc("location-group", locationGroupArg)
+}
+
+// locationGroupLocations returns the union of the locations of the
+// comma-separated location groups in names, in the order they are listed.
+func locationGroupLocations(client *turso.Client, names string) ([]string, error) {
+	config, err := settings.ReadSettings()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read settings: %w", err)
+	}
+	groups := config.LocationGroups()
+
+	var locations []string
+	for _, name := range strings.Split(names, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		members, ok := groups[name]
+		if !ok {
+			return nil, fmt.Errorf("location group %s does not exist. Create it with %s", internal.Emph(name), internal.Emph("turso config location-groups set "+name+" <location-ids>"))
+		}
+		for _, location := range members {
+			if slices.Contains(locations, location) {
+				continue
+			}
+			if !isValidLocation(client, location) {
+				return nil, fmt.Errorf("location group %s: %w", name, invalidLocationError(client, location))
+			}
+			locations = append(locations, location)
+		}
+	}
+	if len(locations) == 0 {
+		return nil, fmt.Errorf("--location-group %s has no locations", names)
+	}
+	return locations, nil
+}
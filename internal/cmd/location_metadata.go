@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"strings"
+)
+
+// countryCodes names the countries that location descriptions give as a code
+// in parentheses, like "Ashburn, Virginia (US)".
+var countryCodes = map[string]string{
+	"US": "United States",
+}
+
+var continents = map[string]string{
+	"Argentina":      "South America",
+	"Australia":      "Oceania",
+	"Brazil":         "South America",
+	"Canada":         "North America",
+	"Chile":          "South America",
+	"Colombia":       "South America",
+	"France":         "Europe",
+	"Germany":        "Europe",
+	"Hong Kong":      "Asia",
+	"India":          "Asia",
+	"Ireland":        "Europe",
+	"Italy":          "Europe",
+	"Japan":          "Asia",
+	"Mexico":         "North America",
+	"Netherlands":    "Europe",
+	"Peru":           "South America",
+	"Poland":         "Europe",
+	"Romania":        "Europe",
+	"Singapore":      "Asia",
+	"South Africa":   "Africa",
+	"South Korea":    "Asia",
+	"Spain":          "Europe",
+	"Sweden":         "Europe",
+	"United Kingdom": "Europe",
+	"United States":  "North America",
+}
+
+// locationCountry returns the country in a location description like
+// "Amsterdam, Netherlands" or "Ashburn, Virginia (US)", or an empty string if
+// it has none.
+func locationCountry(description string) string {
+	i := strings.LastIndex(description, ",")
+	if i == -1 {
+		return ""
+	}
+	last := strings.TrimSpace(description[i+1:])
+	if open := strings.LastIndex(last, "("); open != -1 && strings.HasSuffix(last, ")") {
+		code := last[open+1 : len(last)-1]
+		if country, ok := countryCodes[code]; ok {
+			return country
+		}
+		return code
+	}
+	return last
+}
+
+// locationContinent returns the continent of a country returned by
+// locationCountry, or an empty string if it is not known.
+func locationContinent(country string) string {
+	return continents[country]
+}
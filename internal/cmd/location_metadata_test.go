@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/tursodatabase/turso-cli/internal/turso"
+)
+
+func TestLocationCountry(t *testing.T) {
+	tests := []struct {
+		description string
+		country     string
+		continent   string
+	}{
+		{"Amsterdam, Netherlands", "Netherlands", "Europe"},
+		{"São Paulo, Brazil", "Brazil", "South America"},
+		{"Ashburn, Virginia (US)", "United States", "North America"},
+		{"Somewhere, Atlantis", "Atlantis", ""},
+		{"Nowhere", "", ""},
+	}
+	for _, tt := range tests {
+		country := locationCountry(tt.description)
+		if country != tt.country {
+			t.Errorf("%q: expected country %q, got %q", tt.description, tt.country, country)
+		}
+		if continent := locationContinent(country); continent != tt.continent {
+			t.Errorf("%q: expected continent %q, got %q", tt.description, tt.continent, continent)
+		}
+	}
+}
+
+func TestPrintTableRows(t *testing.T) {
+	tbl := turso.LocationsTable([]interface{}{"ID", "LOCATION"})
+	tbl.AddRow("ams", "Amsterdam, Netherlands  [default]")
+	tbl.AddRow("gru", "São Paulo, Brazil")
+
+	var buf bytes.Buffer
+	printTableRows(&buf, tbl)
+	want := "ams  Amsterdam, Netherlands  [default]  \ngru  São Paulo, Brazil                  \n"
+	if buf.String() != want {
+		t.Errorf("expected\n%q\ngot\n%q", want, buf.String())
+	}
+}
@@ -0,0 +1,145 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/tursodatabase/turso-cli/internal/settings"
+)
+
+// TestMain keeps the settings and cache written by the tests out of the
+// user's configuration directory.
+func TestMain(m *testing.M) {
+	dir, err := os.MkdirTemp("", "turso-cli-test")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	os.Setenv("TURSO_CONFIG_FOLDER", dir)
+	os.Setenv(ENV_DISABLE_UPDATE_CHECK, "1")
+	code := m.Run()
+	os.RemoveAll(dir)
+	os.Exit(code)
+}
+
+type mockResponse struct {
+	status int
+	body   string
+}
+
+// mockTurso is a fake platform API serving canned responses, keyed by method
+// and path.
+type mockTurso struct {
+	*httptest.Server
+	mu        sync.Mutex
+	responses map[string]mockResponse
+	bodies    map[string]string
+}
+
+func newMockTurso(t *testing.T) *mockTurso {
+	m := &mockTurso{responses: map[string]mockResponse{}, bodies: map[string]string{}}
+	m.Server = httptest.NewServer(http.HandlerFunc(m.serve))
+	t.Cleanup(m.Close)
+
+	m.on("GET", "/v1/auth/validate", http.StatusOK, `{"exp": 4102444800}`)
+	m.on("GET", "/v1/locations", http.StatusOK, `{"locations": {"ams": "Amsterdam, Netherlands", "gru": "São Paulo, Brazil"}}`)
+	m.on("GET", "/v1/groups", http.StatusOK, `{"groups": [{"name": "default", "locations": ["ams"], "primary": "ams"}]}`)
+	return m
+}
+
+func (m *mockTurso) on(method, path string, status int, body string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.responses[method+" "+path] = mockResponse{status, body}
+}
+
+func (m *mockTurso) serve(w http.ResponseWriter, r *http.Request) {
+	key := r.Method + " " + r.URL.Path
+	body, _ := io.ReadAll(r.Body)
+
+	m.mu.Lock()
+	m.bodies[key] = string(body)
+	res, ok := m.responses[key]
+	m.mu.Unlock()
+
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, `{"error": "no mock for %s"}`, key)
+		return
+	}
+	w.WriteHeader(res.status)
+	_, _ = io.WriteString(w, res.body)
+}
+
+// received returns the body of the last request to method and path, and
+// whether there was one.
+func (m *mockTurso) received(method, path string) (string, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	body, ok := m.bodies[method+" "+path]
+	return body, ok
+}
+
+// runCommand runs the CLI with args against the mock API and returns what it
+// printed to stdout.
+func runCommand(t *testing.T, m *mockTurso, args ...string) (string, error) {
+	t.Helper()
+	t.Setenv("TURSO_API_BASEURL", m.URL)
+	t.Setenv(ENV_ACCESS_TOKEN, "token")
+	if err := settings.ClearCache(); err != nil {
+		t.Fatal(err)
+	}
+	resetFlags(rootCmd)
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stdout := os.Stdout
+	os.Stdout = w
+	defer func() { os.Stdout = stdout }()
+
+	var out bytes.Buffer
+	done := make(chan struct{})
+	go func() {
+		_, _ = io.Copy(&out, r)
+		close(done)
+	}()
+
+	rootCmd.SetArgs(args)
+	rootCmd.SetErr(io.Discard)
+	err = rootCmd.ExecuteContext(context.Background())
+	w.Close()
+	<-done
+	return out.String(), err
+}
+
+// resetFlags restores the flags of cmd and its subcommands to their defaults,
+// since their values outlive each execution.
+func resetFlags(cmd *cobra.Command) {
+	reset := func(f *pflag.Flag) {
+		if !f.Changed {
+			return
+		}
+		if slice, ok := f.Value.(pflag.SliceValue); ok {
+			_ = slice.Replace(nil)
+		} else {
+			_ = f.Value.Set(f.DefValue)
+		}
+		f.Changed = false
+	}
+	cmd.Flags().VisitAll(reset)
+	cmd.PersistentFlags().VisitAll(reset)
+	for _, child := range cmd.Commands() {
+		resetFlags(child)
+	}
+}
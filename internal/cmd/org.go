@@ -15,6 +15,9 @@ var adminFlag bool
 func init() {
 	rootCmd.AddCommand(orgCmd)
 	orgCmd.AddCommand(orgListCmd)
+	orgCmd.AddCommand(orgShowCmd)
+	addOutputFlag(orgListCmd)
+	addOutputFlag(orgShowCmd)
 	orgCmd.AddCommand(orgCreateCmd)
 	orgCmd.AddCommand(orgDestroyCmd)
 	orgCmd.AddCommand(orgSwitchCmd)
@@ -88,12 +91,10 @@ var orgListCmd = &cobra.Command{
 	Args:              cobra.NoArgs,
 	ValidArgsFunction: noFilesArg,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		cmd.SilenceUsage = true
-
-		settings, err := settings.ReadSettings()
-		if err != nil {
+		if err := validateOutputFlag(); err != nil {
 			return err
 		}
+		cmd.SilenceUsage = true
 
 		client, err := authedTursoClient()
 		if err != nil {
@@ -105,7 +106,14 @@ var orgListCmd = &cobra.Command{
 			return err
 		}
 
-		current := settings.Organization()
+		current := client.Org
+		if jsonOutput() {
+			infos := make([]orgInfo, 0, len(orgs))
+			for _, org := range orgs {
+				infos = append(infos, newOrgInfo(org, current))
+			}
+			return printJSON(infos)
+		}
 
 		data := make([][]string, 0, len(orgs))
 		for _, org := range orgs {
@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/tursodatabase/turso-cli/internal"
+	"github.com/tursodatabase/turso-cli/internal/turso"
+)
+
+type orgInfo struct {
+	Name    string       `json:"name"`
+	Slug    string       `json:"slug"`
+	Type    string       `json:"type"`
+	Current bool         `json:"current"`
+	Members []memberInfo `json:"members,omitempty"`
+}
+
+type memberInfo struct {
+	Username string `json:"username"`
+	Role     string `json:"role"`
+}
+
+func newOrgInfo(org turso.Organization, current string) orgInfo {
+	return orgInfo{
+		Name:    org.Name,
+		Slug:    org.Slug,
+		Type:    org.Type,
+		Current: isCurrentOrg(org, current),
+	}
+}
+
+var orgShowCmd = &cobra.Command{
+	Use:               "show [organization-slug]",
+	Short:             "Show details and members of an organization. Defaults to the current one.",
+	Args:              cobra.MaximumNArgs(1),
+	ValidArgsFunction: orgSwitchArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := validateOutputFlag(); err != nil {
+			return err
+		}
+		cmd.SilenceUsage = true
+
+		client, err := authedTursoClient()
+		if err != nil {
+			return err
+		}
+
+		orgs, err := client.Organizations.List()
+		if err != nil {
+			return err
+		}
+		if len(orgs) == 0 {
+			return fmt.Errorf("your token doesn't have access to any organization")
+		}
+
+		current := client.Org
+		org, err := orgFromArgs(orgs, args, current)
+		if err != nil {
+			return err
+		}
+
+		info := newOrgInfo(org, current)
+		client.Org = org.Slug
+		members, membersErr := client.Organizations.ListMembers()
+		for _, member := range members {
+			info.Members = append(info.Members, memberInfo{Username: member.Name, Role: member.Role})
+		}
+
+		if jsonOutput() {
+			return printJSON(info)
+		}
+
+		fmt.Println("Name:   ", info.Name)
+		fmt.Println("Slug:   ", info.Slug)
+		fmt.Println("Type:   ", info.Type)
+		fmt.Println("Current:", formatBool(info.Current))
+		fmt.Println()
+
+		if membersErr != nil {
+			warnf("could not list members: %s", membersErr)
+			return nil
+		}
+
+		data := make([][]string, 0, len(info.Members))
+		for _, member := range info.Members {
+			data = append(data, []string{member.Username, member.Role})
+		}
+		printTable([]string{"member", "role"}, data)
+		return nil
+	},
+}
+
+// orgFromArgs returns the organization named in args, or the current one.
+func orgFromArgs(orgs []turso.Organization, args []string, current string) (turso.Organization, error) {
+	if len(args) > 0 {
+		return findOrgWithSlug(orgs, args[0])
+	}
+	for _, org := range orgs {
+		if isCurrentOrg(org, current) {
+			return org, nil
+		}
+	}
+	return turso.Organization{}, fmt.Errorf("could not find the current organization. Run %s to see your organizations", internal.Emph("turso org list"))
+}
@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	outputTable  = "table"
+	outputJSON   = "json"
+	outputNDJSON = "ndjson"
+)
+
+var (
+	outputFlag  string
+	compactFlag bool
+)
+
+func addOutputFlag(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&outputFlag, "output", "o", outputTable, "Output format. Possible values: table, json, ndjson.")
+	cmd.Flags().BoolVar(&compactFlag, "compact", false, "Print JSON output on a single line, without indentation.")
+	cmd.RegisterFlagCompletionFunc("output", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return []string{outputTable, outputJSON, outputNDJSON}, cobra.ShellCompDirectiveNoFileComp
+	})
+}
+
+func validateOutputFlag() error {
+	switch outputFlag {
+	case outputTable, outputJSON, outputNDJSON:
+		if compactFlag && !jsonOutput() {
+			return fmt.Errorf("--compact can only be used with --output %s or %s", outputJSON, outputNDJSON)
+		}
+		return nil
+	default:
+		return fmt.Errorf("invalid output format %s. Possible values are %s, %s and %s", outputFlag, outputTable, outputJSON, outputNDJSON)
+	}
+}
+
+// jsonOutput reports whether the output should be JSON, either as a single
+// document or as newline delimited documents.
+func jsonOutput() bool {
+	return outputFlag == outputJSON || outputFlag == outputNDJSON
+}
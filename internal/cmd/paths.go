@@ -0,0 +1,48 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// resolvePath expands a leading ~ to the home directory and $VAR or ${VAR}
+// references in path, since shells leave them alone when the path is quoted.
+func resolvePath(path string) (string, error) {
+	if path == "~" || strings.HasPrefix(path, "~/") {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("could not expand ~ in %s: %w", path, err)
+		}
+		path = home + path[1:]
+	}
+
+	var missing []string
+	expanded := os.Expand(path, func(name string) string {
+		value, ok := os.LookupEnv(name)
+		if !ok {
+			missing = append(missing, name)
+		}
+		return value
+	})
+	if len(missing) > 0 {
+		return "", fmt.Errorf("could not expand %s: environment variable %s is not set", path, missing[0])
+	}
+	return expanded, nil
+}
+
+// resolveOutputPath is resolvePath for files that are going to be written,
+// checking that the directory they go in exists.
+func resolveOutputPath(path string) (string, error) {
+	resolved, err := resolvePath(path)
+	if err != nil {
+		return "", err
+	}
+	dir := filepath.Dir(resolved)
+	info, err := os.Stat(dir)
+	if err != nil || !info.IsDir() {
+		return "", fmt.Errorf("can't write %s: directory %s does not exist", resolved, dir)
+	}
+	return resolved, nil
+}
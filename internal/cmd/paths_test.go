@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestResolvePath(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("TURSO_TEST_DIR", "dumps")
+
+	tests := []struct {
+		path     string
+		expected string
+	}{
+		{"~", home},
+		{"~/db.sql", filepath.Join(home, "db.sql")},
+		{"$TURSO_TEST_DIR/db.sql", "dumps/db.sql"},
+		{"~/${TURSO_TEST_DIR}/db.sql", filepath.Join(home, "dumps", "db.sql")},
+		{"~other/db.sql", "~other/db.sql"},
+		{"db.sql", "db.sql"},
+	}
+	for _, tt := range tests {
+		got, err := resolvePath(tt.path)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.path, err)
+		}
+		if got != tt.expected {
+			t.Errorf("%s: expected %s, got %s", tt.path, tt.expected, got)
+		}
+	}
+
+	if _, err := resolvePath("$TURSO_TEST_UNSET/db.sql"); err == nil || !strings.Contains(err.Error(), "TURSO_TEST_UNSET") {
+		t.Errorf("expected an error naming the unset variable, got %v", err)
+	}
+}
+
+func TestResolveOutputPath(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	if err := os.Mkdir(filepath.Join(home, "out"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := resolveOutputPath("~/out/results.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := filepath.Join(home, "out", "results.csv"); got != expected {
+		t.Errorf("expected %s, got %s", expected, got)
+	}
+	if _, err := resolveOutputPath("~/missing/results.csv"); err == nil || !strings.Contains(err.Error(), "does not exist") {
+		t.Errorf("expected a missing directory error, got %v", err)
+	}
+}
+
+func TestConfigPathIsResolved(t *testing.T) {
+	m := newMockTurso(t)
+	_, err := runCommand(t, m, "--config-path", "$TURSO_TEST_UNSET/turso", "db", "list")
+	if err == nil || !strings.Contains(err.Error(), "TURSO_TEST_UNSET") {
+		t.Errorf("expected --config-path to be expanded, got %v", err)
+	}
+}
@@ -0,0 +1,43 @@
+package cmd
+
+import (
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	plainFlag    bool
+	noHeaderFlag bool
+)
+
+var ansiEscapeRegex = regexp.MustCompile(`\x1b\[[0-9;]*m`)
+
+func addPlainFlag(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&plainFlag, "plain", false, "Print tab-separated columns without borders or colors, for use with tools like cut and awk.")
+}
+
+func addNoHeaderFlag(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&noHeaderFlag, "no-header", false, "Don't print the header row of tables.")
+}
+
+// writePlainTable writes header and data as tab-separated lines, with any
+// color codes removed from the cells.
+func writePlainTable(w io.Writer, header []string, data [][]string) {
+	if !noHeaderFlag {
+		writePlainRow(w, header)
+	}
+	for _, row := range data {
+		writePlainRow(w, row)
+	}
+}
+
+func writePlainRow(w io.Writer, row []string) {
+	cells := make([]string, 0, len(row))
+	for _, cell := range row {
+		cells = append(cells, ansiEscapeRegex.ReplaceAllString(cell, ""))
+	}
+	_, _ = io.WriteString(w, strings.Join(cells, "\t")+"\n")
+}
@@ -436,24 +436,19 @@ func hasPaymentMethodCheck(client *turso.Client, stripeId string) (bool, error)
 
 func checkPaymentMethod(client *turso.Client, stripeId string) (bool, error) {
 	errsInARoW := 0
-	var hasPaymentMethod bool
-	var err error
-	for {
-		hasPaymentMethod, err = hasPaymentMethodCheck(client, stripeId)
+	err := pollUntil(commandContext(), func() (bool, error) {
+		hasPaymentMethod, err := hasPaymentMethodCheck(client, stripeId)
 		if err != nil {
 			errsInARoW += 1
+			if errsInARoW > 5 {
+				return false, err
+			}
+			return false, nil
 		}
-		if errsInARoW > 5 {
-			return false, err
-		}
-		if err == nil {
-			errsInARoW = 0
-		}
-		if hasPaymentMethod {
-			return true, nil
-		}
-		time.Sleep(1 * time.Second)
-	}
+		errsInARoW = 0
+		return hasPaymentMethod, nil
+	})
+	return err == nil, err
 }
 
 func PaymentMethodHelperOverages(client *turso.Client) (bool, error) {
@@ -0,0 +1,65 @@
+package cmd
+
+import (
+	"context"
+	"math/rand"
+	"time"
+)
+
+type clock interface {
+	After(d time.Duration) <-chan time.Time
+}
+
+type realClock struct{}
+
+func (realClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+// poller calls a check with exponentially growing intervals, capped at max.
+// Each interval is jittered so that many clients don't poll in lockstep.
+type poller struct {
+	initial time.Duration
+	max     time.Duration
+	clock   clock
+	rand    func() float64
+}
+
+var defaultPoller = poller{
+	initial: 500 * time.Millisecond,
+	max:     10 * time.Second,
+	clock:   realClock{},
+	rand:    rand.Float64,
+}
+
+// pollUntil calls check until it reports done, returns an error or ctx is
+// done, backing off between calls.
+func pollUntil(ctx context.Context, check func() (bool, error)) error {
+	return defaultPoller.poll(ctx, check)
+}
+
+func (p poller) poll(ctx context.Context, check func() (bool, error)) error {
+	interval := p.initial
+	for {
+		done, err := check()
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-p.clock.After(p.jitter(interval)):
+		}
+		interval = min(interval*2, p.max)
+	}
+}
+
+// jitter returns a random duration between d/2 and d.
+func (p poller) jitter(d time.Duration) time.Duration {
+	half := d / 2
+	return half + time.Duration(p.rand()*float64(d-half))
+}
@@ -0,0 +1,104 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type fakeClock struct {
+	waits []time.Duration
+}
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	c.waits = append(c.waits, d)
+	ch := make(chan time.Time, 1)
+	ch <- time.Time{}
+	return ch
+}
+
+func testPoller(clock *fakeClock, jitter float64) poller {
+	return poller{
+		initial: time.Second,
+		max:     10 * time.Second,
+		clock:   clock,
+		rand:    func() float64 { return jitter },
+	}
+}
+
+func doneAfter(n int) func() (bool, error) {
+	calls := 0
+	return func() (bool, error) {
+		calls++
+		return calls > n, nil
+	}
+}
+
+func Test_pollUntilBackoff(t *testing.T) {
+	clock := &fakeClock{}
+	if err := testPoller(clock, 1).poll(context.Background(), doneAfter(6)); err != nil {
+		t.Fatalf("poll() error = %v", err)
+	}
+	want := []time.Duration{1 * time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 10 * time.Second, 10 * time.Second}
+	if !reflect.DeepEqual(clock.waits, want) {
+		t.Errorf("poll() waited %v, want %v", clock.waits, want)
+	}
+}
+
+func Test_pollUntilJitter(t *testing.T) {
+	clock := &fakeClock{}
+	if err := testPoller(clock, 0).poll(context.Background(), doneAfter(3)); err != nil {
+		t.Fatalf("poll() error = %v", err)
+	}
+	want := []time.Duration{500 * time.Millisecond, 1 * time.Second, 2 * time.Second}
+	if !reflect.DeepEqual(clock.waits, want) {
+		t.Errorf("poll() waited %v, want %v", clock.waits, want)
+	}
+}
+
+func Test_pollUntilDoneImmediately(t *testing.T) {
+	clock := &fakeClock{}
+	if err := testPoller(clock, 1).poll(context.Background(), doneAfter(0)); err != nil {
+		t.Fatalf("poll() error = %v", err)
+	}
+	if len(clock.waits) != 0 {
+		t.Errorf("poll() waited %v, want no waits", clock.waits)
+	}
+}
+
+func Test_pollUntilError(t *testing.T) {
+	clock := &fakeClock{}
+	want := errors.New("boom")
+	calls := 0
+	err := testPoller(clock, 1).poll(context.Background(), func() (bool, error) {
+		calls++
+		if calls == 3 {
+			return false, want
+		}
+		return false, nil
+	})
+	if !errors.Is(err, want) {
+		t.Errorf("poll() error = %v, want %v", err, want)
+	}
+	if len(clock.waits) != 2 {
+		t.Errorf("poll() waited %d times, want 2", len(clock.waits))
+	}
+}
+
+type stoppedClock struct{}
+
+func (stoppedClock) After(d time.Duration) <-chan time.Time {
+	return nil
+}
+
+func Test_pollUntilCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	p := poller{initial: time.Second, max: time.Second, clock: stoppedClock{}, rand: func() float64 { return 1 }}
+	err := p.poll(ctx, func() (bool, error) { return false, nil })
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("poll() error = %v, want %v", err, context.Canceled)
+	}
+}
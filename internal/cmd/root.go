@@ -1,16 +1,19 @@
 package cmd
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"os"
 	"time"
 
-	semver "github.com/hashicorp/go-version"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"github.com/tursodatabase/turso-cli/internal"
 	"github.com/tursodatabase/turso-cli/internal/flags"
+	"github.com/tursodatabase/turso-cli/internal/prompt"
 	"github.com/tursodatabase/turso-cli/internal/settings"
+	"github.com/tursodatabase/turso-cli/internal/turso"
 )
 
 var rootCmd = &cobra.Command{
@@ -19,11 +22,38 @@ var rootCmd = &cobra.Command{
 	Long:    "Turso CLI",
 }
 
+// exitCodeTimeout is used when a command gives up waiting for something, like
+// timeout(1) does.
+const exitCodeTimeout = 124
+
 func Execute() {
-	err := rootCmd.Execute()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	handleInterrupts(cancel)
+
+	start := time.Now()
+	cmd, err := rootCmd.ExecuteContextC(ctx)
+	if flags.Timings() {
+		reportTimings(os.Stderr, time.Since(start))
+	}
+	if err != nil && ctx.Err() != nil {
+		fmt.Fprintln(os.Stderr, "Aborted.")
+		os.Exit(exitCodeInterrupted)
+	}
 	if err != nil {
-		os.Exit(1)
+		os.Exit(exitCode(cmd, err))
+	}
+}
+
+// exitCode returns the exit code for the error returned by cmd.
+func exitCode(cmd *cobra.Command, err error) int {
+	if cmd == diffCmd {
+		return diffExitCode(err)
+	}
+	if errors.Is(err, errTimeout) {
+		return exitCodeTimeout
 	}
+	return 1
 }
 
 var noMultipleTokenSourcesWarning bool
@@ -36,6 +66,9 @@ func init() {
 	rootCmd.PersistentFlags().BoolVar(&noMultipleTokenSourcesWarning, "no-multiple-token-sources-warning", false, "Don't warn about multiple access token sources")
 	_ = rootCmd.PersistentFlags().MarkHidden("no-multiple-token-sources-warning")
 
+	rootCmd.PersistentFlags().BoolVar(&noUpdateCheckFlag, "no-update-check", false, "Don't check for new versions of the CLI")
+	rootCmd.PersistentPreRunE = rootPreRun
+
 	rootCmd.PersistentPostRun = func(cmd *cobra.Command, args []string) {
 		configSettings, err := settings.ReadSettings()
 		if err != nil {
@@ -51,6 +84,7 @@ func init() {
 		if version == "dev" {
 			return
 		}
+		notifyUpdate(configSettings)
 		if configSettings.GetAutoupdate() == "on" && time.Now().Unix() >= configSettings.GetLastUpdateCheck()+int64(24*60*60) {
 			latest, err := fetchLatestVersion()
 			if err != nil {
@@ -58,18 +92,13 @@ func init() {
 				return
 			}
 
-			parsedVersion, err := semver.NewVersion(version)
-			if err != nil {
-				_, _ = fmt.Fprintln(os.Stderr, "Error parsing current version:", err)
-				return
-			}
-			parsedLatest, err := semver.NewVersion(latest)
+			newer, err := isNewerVersion(version, latest)
 			if err != nil {
-				_, _ = fmt.Fprintln(os.Stderr, "Error parsing latest version:", err)
+				_, _ = fmt.Fprintln(os.Stderr, err)
 				return
 			}
 
-			if parsedVersion.LessThan(parsedLatest) {
+			if newer {
 				fmt.Println("Updating to the latest version")
 				err := Update()
 				if err != nil {
@@ -81,7 +110,46 @@ func init() {
 			settings.PersistChanges()
 		}
 	}
-	rootCmd.CompletionOptions.HiddenDefaultCmd = true
 	flags.AddDebugFlag(rootCmd)
+	flags.AddVerboseFlag(rootCmd)
+	flags.AddTraceFlag(rootCmd)
+	flags.AddTimingsFlag(rootCmd)
+	flags.AddQuietFlag(rootCmd)
+	flags.AddOrg(rootCmd)
+	flags.AddMaxConcurrency(rootCmd)
+	flags.AddProbeTimeout(rootCmd)
+	flags.AddColor(rootCmd)
 	flags.AddResetConfigFlag(rootCmd)
 }
+
+// rootPreRun applies the global flags. Commands with their own
+// PersistentPreRunE must call it first, since cobra only runs the closest one.
+func rootPreRun(cmd *cobra.Command, args []string) error {
+	if flag := rootCmd.PersistentFlags().Lookup("config-path"); flag.Changed {
+		path, err := resolvePath(flag.Value.String())
+		if err != nil {
+			return err
+		}
+		viper.Set("config-path", path)
+	}
+	if err := flags.ApplyColor(); err != nil {
+		return err
+	}
+	if err := flags.ValidateMaxConcurrency(); err != nil {
+		return err
+	}
+	prompt.SetQuiet(flags.Quiet())
+	if path := flags.Trace(); path != "" {
+		path, err := resolveOutputPath(path)
+		if err != nil {
+			return err
+		}
+		f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+		if err != nil {
+			return fmt.Errorf("could not open trace file: %w", err)
+		}
+		turso.TraceTo(f)
+	}
+	startUpdateCheck()
+	return nil
+}
@@ -0,0 +1,76 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/tursodatabase/turso-cli/internal"
+	"github.com/tursodatabase/turso-cli/internal/turso"
+)
+
+var schemaDBFlag string
+
+func addSchemaDBFlag(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&schemaDBFlag, "schema-db", "", "Copy the schema (tables, indexes, views and triggers, without data) of an existing database")
+	cmd.RegisterFlagCompletionFunc("schema-db", dbNameArg)
+}
+
+// readSchema returns the DDL statements of a database, in creation order.
+func readSchema(client *turso.Client, name string) ([]string, error) {
+	db, err := getDatabase(client, name)
+	if err != nil {
+		return nil, fmt.Errorf("could not find schema database %s: %w", internal.Emph(name), err)
+	}
+
+	token, err := tokenFromDb(&db, client, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not access schema database %s: %w", internal.Emph(name), err)
+	}
+
+	rs, err := queryRows(getDatabaseHttpUrl(&db), token, "SELECT sql FROM sqlite_master WHERE sql IS NOT NULL AND name NOT LIKE 'sqlite_%' AND name NOT LIKE '_litestream_%' AND name NOT LIKE 'libsql_%' ORDER BY rowid")
+	if err != nil {
+		return nil, fmt.Errorf("could not read schema of database %s: %w", internal.Emph(name), err)
+	}
+
+	statements := make([]string, 0, len(rs.Rows))
+	for _, row := range rs.Rows {
+		if len(row) > 0 && row[0] != nil {
+			statements = append(statements, fmt.Sprint(row[0]))
+		}
+	}
+	return statements, nil
+}
+
+// applySchema executes statements in db once it is ready to answer queries.
+// Progress is reported through status.
+func applySchema(client *turso.Client, db turso.Database, statements []string, status func(string)) error {
+	if len(statements) == 0 {
+		return nil
+	}
+
+	token, err := tokenFromDb(&db, client, nil)
+	if err != nil {
+		return err
+	}
+	dbURL := getDatabaseHttpUrl(&db)
+
+	ctx, cancel := context.WithTimeout(commandContext(), createTimeoutFlag)
+	defer cancel()
+	status(fmt.Sprintf("Waiting for database %s to be ready...", internal.Emph(db.Name)))
+	if _, err := waitUntilReady(ctx, dbURL, token); err != nil {
+		return fmt.Errorf("database %s was not ready after %s: %w", db.Name, createTimeoutFlag, err)
+	}
+
+	status(fmt.Sprintf("Copying schema of %s to %s...", internal.Emph(schemaDBFlag), internal.Emph(db.Name)))
+	results, err := executeStatements(dbURL, token, statements)
+	if err != nil {
+		return err
+	}
+	for i, result := range results {
+		if result.Error != nil {
+			return fmt.Errorf("%s\n%s", result.Error.Message, statements[i])
+		}
+	}
+	return nil
+}
@@ -0,0 +1,188 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/tursodatabase/turso-cli/internal"
+	"github.com/tursodatabase/turso-cli/internal/turso"
+)
+
+var seedRowsFlag int
+
+func addSeedRowsFlag(cmd *cobra.Command) {
+	cmd.Flags().IntVar(&seedRowsFlag, "seed-rows", 0, "Insert this many generated rows into the first table of the schema given with --schema or --schema-db")
+}
+
+func validateSeedRows() error {
+	if seedRowsFlag == 0 {
+		return nil
+	}
+	if seedRowsFlag < 0 {
+		return fmt.Errorf("--seed-rows must be positive")
+	}
+	if schemaFlag == "" && schemaDBFlag == "" {
+		return fmt.Errorf("--seed-rows requires --schema or --schema-db, so that the database has a table to insert into")
+	}
+	return nil
+}
+
+type seedColumn struct {
+	name     string
+	declared string
+}
+
+// seedRows inserts count generated rows into the first table of db, picking
+// values that match the declared type and name of each column.
+func seedRows(client *turso.Client, db turso.Database, count int, status func(string)) (string, error) {
+	ctx, cancel := context.WithTimeout(commandContext(), createTimeoutFlag)
+	defer cancel()
+
+	token, err := tokenFromDb(&db, client, nil)
+	if err != nil {
+		return "", err
+	}
+	dbURL := getDatabaseHttpUrl(&db)
+
+	status(fmt.Sprintf("Waiting for database %s to be ready...", internal.Emph(db.Name)))
+	if _, err := waitUntilReady(ctx, dbURL, token); err != nil {
+		return "", fmt.Errorf("database %s was not ready after %s: %w", db.Name, createTimeoutFlag, err)
+	}
+
+	rs, err := queryRows(dbURL, token, "SELECT name FROM sqlite_master WHERE type = 'table' AND name NOT LIKE 'sqlite_%' AND name NOT LIKE '_litestream_%' AND name NOT LIKE 'libsql_%' ORDER BY rowid LIMIT 1")
+	if err != nil {
+		return "", err
+	}
+	if len(rs.Rows) == 0 || len(rs.Rows[0]) == 0 {
+		return "", errors.New("the schema has no tables")
+	}
+	table := fmt.Sprint(rs.Rows[0][0])
+
+	columns, err := seedColumns(dbURL, token, table)
+	if err != nil {
+		return "", err
+	}
+	names := make([]string, 0, len(columns))
+	for _, column := range columns {
+		names = append(names, column.name)
+	}
+	query := insertQuery("INSERT", table, names)
+
+	for inserted := 0; inserted < count; {
+		n := min(importBatchSize, count-inserted)
+		statements := make([]paramStatement, 0, n+2)
+		statements = append(statements, paramStatement{SQL: "BEGIN"})
+		for i := inserted + 1; i <= inserted+n; i++ {
+			params := make([]interface{}, 0, len(columns))
+			for _, column := range columns {
+				params = append(params, seedValue(column, i))
+			}
+			statements = append(statements, paramStatement{SQL: query, Params: params})
+		}
+		statements = append(statements, paramStatement{SQL: "COMMIT"})
+
+		results, err := executeParamStatements(dbURL, token, statements)
+		if err != nil {
+			return table, err
+		}
+		for _, result := range results {
+			if result.Error != nil {
+				return table, fmt.Errorf("could not insert into table %s: %s", table, result.Error.Message)
+			}
+		}
+		inserted += n
+		status(fmt.Sprintf("Inserting generated rows into table %s... %d/%d", internal.Emph(table), inserted, count))
+	}
+	return table, nil
+}
+
+// seedColumns returns the columns of table that need a value, leaving out the
+// INTEGER PRIMARY KEY, which SQLite fills in.
+func seedColumns(dbURL, token, table string) ([]seedColumn, error) {
+	rs, err := queryRows(dbURL, token, fmt.Sprintf("PRAGMA table_info(%s)", quoteIdentifier(table)))
+	if err != nil {
+		return nil, err
+	}
+
+	var columns []seedColumn
+	keys := 0
+	rowid := ""
+	for _, row := range rs.Rows {
+		if len(row) < 6 {
+			continue
+		}
+		column := seedColumn{name: fmt.Sprint(row[1]), declared: strings.ToUpper(fmt.Sprint(row[2]))}
+		if fmt.Sprint(row[5]) != "0" {
+			keys++
+			if column.declared == "INTEGER" {
+				rowid = column.name
+			}
+		}
+		columns = append(columns, column)
+	}
+	if keys == 1 && rowid != "" {
+		for i, column := range columns {
+			if column.name == rowid {
+				columns = append(columns[:i], columns[i+1:]...)
+				break
+			}
+		}
+	}
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("table %s has no columns to fill", table)
+	}
+	return columns, nil
+}
+
+var seedNames = []string{"Ada", "Grace", "Linus", "Barbara", "Dennis", "Margaret", "Ken", "Frances", "Edsger", "Radia"}
+
+// seedValue returns a value for the i-th generated row, following the rules
+// SQLite uses to give a column its type affinity from its declared type.
+func seedValue(column seedColumn, i int) interface{} {
+	name := strings.ToLower(column.name)
+	declared := column.declared
+	switch {
+	case strings.Contains(declared, "INT"):
+		if strings.HasPrefix(name, "is_") || strings.HasPrefix(name, "has_") {
+			return i % 2
+		}
+		return i
+	case strings.Contains(declared, "CHAR"), strings.Contains(declared, "CLOB"), strings.Contains(declared, "TEXT"), declared == "":
+		return seedText(name, i)
+	case strings.Contains(declared, "BLOB"):
+		return fmt.Sprintf("%s-%d", column.name, i)
+	case strings.Contains(declared, "REAL"), strings.Contains(declared, "FLOA"), strings.Contains(declared, "DOUB"):
+		return float64(i) * 1.25
+	case strings.Contains(declared, "DATE"), strings.Contains(declared, "TIME"):
+		return seedDate(i)
+	case strings.Contains(declared, "BOOL"):
+		return i % 2
+	default:
+		return i
+	}
+}
+
+func seedText(name string, i int) string {
+	person := seedNames[(i-1)%len(seedNames)]
+	switch {
+	case strings.Contains(name, "email"):
+		return fmt.Sprintf("%s%d@example.com", strings.ToLower(person), i)
+	case strings.Contains(name, "url"), strings.Contains(name, "website"):
+		return fmt.Sprintf("https://example.com/%d", i)
+	case strings.HasSuffix(name, "_at"), strings.Contains(name, "date"), strings.Contains(name, "time"):
+		return seedDate(i)
+	case strings.Contains(name, "name"):
+		return fmt.Sprintf("%s %d", person, i)
+	default:
+		return fmt.Sprintf("%s %d", name, i)
+	}
+}
+
+func seedDate(i int) string {
+	start := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	return start.Add(time.Duration(i) * time.Hour).Format("2006-01-02 15:04:05")
+}
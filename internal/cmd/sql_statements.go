@@ -0,0 +1,161 @@
+package cmd
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strings"
+)
+
+type sqlStatement struct {
+	SQL  string
+	Line int
+}
+
+var (
+	createTriggerRegex = regexp.MustCompile(`(?is)^\s*CREATE\s+(TEMP\s+|TEMPORARY\s+)?TRIGGER\b`)
+	triggerEndRegex    = regexp.MustCompile(`(?is)\bEND\s*$`)
+)
+
+type scannerState int
+
+const (
+	stateCode scannerState = iota
+	stateSingleQuote
+	stateDoubleQuote
+	stateBacktick
+	stateBracket
+	stateLineComment
+	stateBlockComment
+)
+
+// statementScanner splits SQL text into statements. Semicolons inside string
+// literals, quoted identifiers, comments and trigger bodies don't terminate a
+// statement.
+type statementScanner struct {
+	r    *bufio.Reader
+	line int
+	stmt sqlStatement
+	err  error
+}
+
+func newStatementScanner(r io.Reader) *statementScanner {
+	return &statementScanner{r: bufio.NewReader(r), line: 1}
+}
+
+func (s *statementScanner) Scan() bool {
+	var text, code strings.Builder
+	state := stateCode
+	start := 0
+
+	for {
+		c, _, err := s.r.ReadRune()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			s.err = err
+			return false
+		}
+		line := s.line
+		if c == '\n' {
+			s.line++
+		}
+		text.WriteRune(c)
+
+		switch state {
+		case stateCode:
+			switch {
+			case c == '-' && s.next('-'):
+				text.WriteRune('-')
+				code.WriteRune(' ')
+				state = stateLineComment
+				continue
+			case c == '/' && s.next('*'):
+				text.WriteRune('*')
+				code.WriteRune(' ')
+				state = stateBlockComment
+				continue
+			case c == ';':
+				if isIncompleteTrigger(code.String()) {
+					break
+				}
+				if strings.TrimSpace(code.String()) == "" {
+					text.Reset()
+					code.Reset()
+					start = 0
+					continue
+				}
+				sql := text.String()
+				s.stmt = sqlStatement{SQL: strings.TrimSpace(sql[:len(sql)-1]), Line: start}
+				return true
+			case c == '\'':
+				state = stateSingleQuote
+			case c == '"':
+				state = stateDoubleQuote
+			case c == '`':
+				state = stateBacktick
+			case c == '[':
+				state = stateBracket
+			}
+			if start == 0 && !isSpace(c) {
+				start = line
+			}
+			code.WriteRune(c)
+		case stateSingleQuote, stateDoubleQuote, stateBacktick, stateBracket:
+			if c == closingQuote[state] {
+				state = stateCode
+			}
+			code.WriteRune(c)
+		case stateLineComment:
+			if c == '\n' {
+				state = stateCode
+				code.WriteRune(c)
+			}
+		case stateBlockComment:
+			if c == '*' && s.next('/') {
+				text.WriteRune('/')
+				state = stateCode
+			}
+		}
+	}
+
+	if strings.TrimSpace(code.String()) == "" {
+		return false
+	}
+	s.stmt = sqlStatement{SQL: strings.TrimSpace(text.String()), Line: start}
+	return true
+}
+
+var closingQuote = map[scannerState]rune{
+	stateSingleQuote: '\'',
+	stateDoubleQuote: '"',
+	stateBacktick:    '`',
+	stateBracket:     ']',
+}
+
+// next consumes the next byte if it is b.
+func (s *statementScanner) next(b byte) bool {
+	peek, err := s.r.Peek(1)
+	if err != nil || peek[0] != b {
+		return false
+	}
+	_, _ = s.r.ReadByte()
+	return true
+}
+
+func isIncompleteTrigger(code string) bool {
+	return createTriggerRegex.MatchString(code) && !triggerEndRegex.MatchString(code)
+}
+
+func (s *statementScanner) Statement() sqlStatement {
+	return s.stmt
+}
+
+func (s *statementScanner) Err() error {
+	return s.err
+}
+
+func isSpace(c rune) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestStatementScanner(t *testing.T) {
+	tests := []struct {
+		name string
+		sql  string
+		want []sqlStatement
+	}{
+		{
+			name: "semicolons in strings",
+			sql:  "INSERT INTO t VALUES ('a;b');\nINSERT INTO t VALUES ('it''s; fine');",
+			want: []sqlStatement{
+				{SQL: "INSERT INTO t VALUES ('a;b')", Line: 1},
+				{SQL: "INSERT INTO t VALUES ('it''s; fine')", Line: 2},
+			},
+		},
+		{
+			name: "semicolons in identifiers",
+			sql:  "SELECT \"a;b\", [c;d], `e;f` FROM t;\nSELECT 1;",
+			want: []sqlStatement{
+				{SQL: "SELECT \"a;b\", [c;d], `e;f` FROM t", Line: 1},
+				{SQL: "SELECT 1", Line: 2},
+			},
+		},
+		{
+			name: "semicolons in comments",
+			sql:  "-- first; second\nSELECT 1; /* one;\ntwo; */ SELECT 2;",
+			want: []sqlStatement{
+				{SQL: "-- first; second\nSELECT 1", Line: 2},
+				{SQL: "/* one;\ntwo; */ SELECT 2", Line: 3},
+			},
+		},
+		{
+			name: "trigger body",
+			sql:  "CREATE TRIGGER log AFTER INSERT ON t BEGIN\n  INSERT INTO audit VALUES (new.id);\n  UPDATE counts SET n = n + 1;\nEND;\nSELECT 1;",
+			want: []sqlStatement{
+				{SQL: "CREATE TRIGGER log AFTER INSERT ON t BEGIN\n  INSERT INTO audit VALUES (new.id);\n  UPDATE counts SET n = n + 1;\nEND", Line: 1},
+				{SQL: "SELECT 1", Line: 5},
+			},
+		},
+		{
+			name: "final statement without a semicolon",
+			sql:  "SELECT 1;\n\nSELECT 2\n",
+			want: []sqlStatement{
+				{SQL: "SELECT 1", Line: 1},
+				{SQL: "SELECT 2", Line: 3},
+			},
+		},
+		{
+			name: "empty statements",
+			sql:  ";\n ; -- nothing\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []sqlStatement
+			scanner := newStatementScanner(strings.NewReader(tt.sql))
+			for scanner.Scan() {
+				got = append(got, scanner.Statement())
+			}
+			if err := scanner.Err(); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/template"
+
+	"github.com/spf13/cobra"
+	"github.com/tursodatabase/turso-cli/internal/turso"
+)
+
+const outputTemplate = "template"
+
+var (
+	templateFlag     string
+	templateFileFlag string
+)
+
+// addTemplateFlags adds --output template support to a command that already
+// has the output flag.
+func addTemplateFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&templateFlag, "template", "", "Go template used to render each record with --output template, for example '{{.Name}} {{.Hostname}}'.")
+	cmd.Flags().StringVar(&templateFileFlag, "template-file", "", "File with the Go template used to render each record with --output template.")
+	cmd.MarkFlagsMutuallyExclusive("template", "template-file")
+	cmd.Flags().Lookup("output").Usage = "Output format. Possible values: table, json, ndjson, template."
+	cmd.RegisterFlagCompletionFunc("output", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return []string{outputTable, outputJSON, outputNDJSON, outputTemplate}, cobra.ShellCompDirectiveNoFileComp
+	})
+}
+
+// parseOutputTemplate validates the output flags of a command that supports
+// templates. It returns the parsed template with --output template, and nil
+// otherwise.
+func parseOutputTemplate() (*template.Template, error) {
+	if outputFlag != outputTemplate {
+		if templateFlag != "" || templateFileFlag != "" {
+			return nil, fmt.Errorf("--template and --template-file can only be used with --output template")
+		}
+		return nil, validateOutputFlag()
+	}
+
+	text := templateFlag
+	if templateFileFlag != "" {
+		path, err := resolvePath(templateFileFlag)
+		if err != nil {
+			return nil, err
+		}
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("could not read template file %s: %w", templateFileFlag, err)
+		}
+		text = string(b)
+	}
+	if text == "" {
+		return nil, fmt.Errorf("--output template requires --template or --template-file")
+	}
+
+	tmpl, err := template.New("output").Funcs(template.FuncMap{"join": strings.Join}).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid template: %w", err)
+	}
+	return tmpl, nil
+}
+
+// executeTemplate renders each record with tmpl, one per line.
+func executeTemplate(w io.Writer, tmpl *template.Template, records ...interface{}) error {
+	for _, record := range records {
+		var sb strings.Builder
+		if err := tmpl.Execute(&sb, record); err != nil {
+			return fmt.Errorf("could not render template: %w", err)
+		}
+		out := sb.String()
+		if !strings.HasSuffix(out, "\n") {
+			out += "\n"
+		}
+		if _, err := io.WriteString(w, out); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// databaseTemplateData is the context of templates rendering a database.
+// The fields of turso.Database are available directly, along with its URL
+// and, for db show, its instances.
+type databaseTemplateData struct {
+	turso.Database
+	URL       string
+	Instances []turso.Instance
+}
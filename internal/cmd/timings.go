@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/tursodatabase/turso-cli/internal/flags"
+)
+
+type phaseTiming struct {
+	name    string
+	elapsed time.Duration
+}
+
+var (
+	timingsMu sync.Mutex
+	timings   []phaseTiming
+)
+
+// timePhase starts timing a phase of the command for --timings and returns the
+// function that stops it. The time of phases with the same name is added up,
+// so phases that run concurrently can add up to more than the total.
+func timePhase(name string) func() {
+	if !flags.Timings() {
+		return func() {}
+	}
+	start := time.Now()
+	return func() {
+		elapsed := time.Since(start)
+		timingsMu.Lock()
+		defer timingsMu.Unlock()
+		for i := range timings {
+			if timings[i].name == name {
+				timings[i].elapsed += elapsed
+				return
+			}
+		}
+		timings = append(timings, phaseTiming{name, elapsed})
+	}
+}
+
+// reportTimings writes the time spent in each phase, in the order they first
+// started, followed by the total. Nothing is written with JSON output, so
+// that stderr only carries errors for scripts parsing the output.
+func reportTimings(w io.Writer, total time.Duration) {
+	if jsonOutput() {
+		return
+	}
+	timingsMu.Lock()
+	defer timingsMu.Unlock()
+
+	fmt.Fprintln(w, "Timings:")
+	for _, timing := range timings {
+		fmt.Fprintf(w, "  %-8s %s\n", timing.name, formatTiming(timing.elapsed))
+	}
+	fmt.Fprintf(w, "  %-8s %s\n", "total", formatTiming(total))
+}
+
+func formatTiming(d time.Duration) string {
+	if d < time.Second {
+		return d.Round(time.Millisecond).String()
+	}
+	return d.Round(10 * time.Millisecond).String()
+}
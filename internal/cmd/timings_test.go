@@ -0,0 +1,41 @@
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestReportTimings(t *testing.T) {
+	if err := rootCmd.PersistentFlags().Set("timings", "true"); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		resetFlags(rootCmd)
+		timings = nil
+		outputFlag = outputTable
+	})
+
+	timePhase("auth")()
+	timePhase("list")()
+	timePhase("auth")()
+	if len(timings) != 2 || timings[0].name != "auth" || timings[1].name != "list" {
+		t.Fatalf("expected auth and list phases, got %v", timings)
+	}
+
+	outputFlag = outputTable
+	var out bytes.Buffer
+	reportTimings(&out, 1500*time.Millisecond)
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 4 || !strings.HasPrefix(strings.TrimSpace(lines[1]), "auth") || lines[3] != "  total    1.5s" {
+		t.Errorf("unexpected report:\n%s", out.String())
+	}
+
+	outputFlag = outputJSON
+	out.Reset()
+	reportTimings(&out, 1500*time.Millisecond)
+	if out.Len() != 0 {
+		t.Errorf("expected no report with JSON output, got %q", out.String())
+	}
+}
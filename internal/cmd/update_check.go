@@ -0,0 +1,94 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	semver "github.com/hashicorp/go-version"
+	"github.com/tursodatabase/turso-cli/internal"
+	"github.com/tursodatabase/turso-cli/internal/settings"
+)
+
+const ENV_DISABLE_UPDATE_CHECK = "TURSO_DISABLE_UPDATE_CHECK"
+
+var (
+	noUpdateCheckFlag bool
+	latestVersionCh   chan string
+)
+
+// updateCheckDue reports whether we should look for a new version in the
+// background. Users with autoupdate on get updated instead of notified.
+func updateCheckDue(config *settings.Settings) bool {
+	if noUpdateCheckFlag || os.Getenv(ENV_DISABLE_UPDATE_CHECK) != "" {
+		return false
+	}
+	if version == "dev" || !isInteractive() {
+		return false
+	}
+	if config.GetAutoupdate() == "on" {
+		return false
+	}
+	return time.Now().Unix() >= config.GetLastUpdateCheck()+int64(24*60*60)
+}
+
+// startUpdateCheck fetches the latest version in the background so the
+// command never waits on it. The result is picked up by notifyUpdate.
+func startUpdateCheck() {
+	config, err := settings.ReadSettings()
+	if err != nil || !updateCheckDue(config) {
+		return
+	}
+
+	// the client reads the settings, so build it before leaving this goroutine
+	client, err := unauthedTursoClient()
+	if err != nil {
+		return
+	}
+
+	ch := make(chan string, 1)
+	latestVersionCh = ch
+	go func() {
+		latest, err := fetchLatestVersionWith(client)
+		if err != nil {
+			latest = ""
+		}
+		ch <- latest
+	}()
+}
+
+func notifyUpdate(config *settings.Settings) {
+	if latestVersionCh == nil {
+		return
+	}
+
+	var latest string
+	select {
+	case latest = <-latestVersionCh:
+	default:
+		// the check didn't finish in time, we'll try again next time
+		return
+	}
+
+	config.SetLastUpdateCheck(time.Now().Unix())
+	settings.PersistChanges()
+	if latest == "" {
+		return
+	}
+
+	if newer, err := isNewerVersion(version, latest); err == nil && newer {
+		fmt.Fprintf(os.Stderr, "\nA new version of turso is available: %s → %s. Run %s to update.\n", version, internal.Emph(latest), internal.Emph("turso update"))
+	}
+}
+
+func isNewerVersion(current, latest string) (bool, error) {
+	parsedVersion, err := semver.NewVersion(current)
+	if err != nil {
+		return false, fmt.Errorf("Error parsing current version: %w", err)
+	}
+	parsedLatest, err := semver.NewVersion(latest)
+	if err != nil {
+		return false, fmt.Errorf("Error parsing latest version: %w", err)
+	}
+	return parsedVersion.LessThan(parsedLatest), nil
+}
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"reflect"
 	"strings"
 	"time"
 
@@ -16,6 +17,7 @@ import (
 
 	"github.com/olekukonko/tablewriter"
 	"github.com/tursodatabase/turso-cli/internal"
+	"github.com/tursodatabase/turso-cli/internal/flags"
 	"github.com/tursodatabase/turso-cli/internal/prompt"
 	"github.com/tursodatabase/turso-cli/internal/settings"
 	"github.com/tursodatabase/turso-cli/internal/turso"
@@ -27,6 +29,7 @@ const (
 )
 
 func authedTursoClient() (*turso.Client, error) {
+	defer timePhase("auth")()
 	token, err := getAccessToken()
 	if err != nil {
 		return nil, err
@@ -51,7 +54,12 @@ func tursoClient(token string) (*turso.Client, error) {
 	}
 
 	org := config.Organization()
-	return turso.New(tursoUrl, token, version, org), nil
+	if override := flags.Org(); override != "" {
+		org = override
+	}
+	client := turso.New(tursoUrl, token, version, org)
+	client.SetContext(commandContext())
+	return client, nil
 }
 
 func filterInstancesByRegion(instances []turso.Instance, region string) []turso.Instance {
@@ -120,9 +128,19 @@ func formatLocations(locations []string, primary string) string {
 }
 
 func printTable(header []string, data [][]string) {
-	table := tablewriter.NewWriter(os.Stdout)
+	writeTable(os.Stdout, header, data)
+}
+
+func writeTable(w io.Writer, header []string, data [][]string) {
+	if plainFlag {
+		writePlainTable(w, header, data)
+		return
+	}
+	table := tablewriter.NewWriter(w)
 
-	table.SetHeader(header)
+	if !noHeaderFlag {
+		table.SetHeader(header)
+	}
 	table.SetHeaderLine(false)
 	table.SetHeaderAlignment(tablewriter.ALIGN_LEFT)
 	table.SetAutoFormatHeaders(true)
@@ -139,6 +157,40 @@ func printTable(header []string, data [][]string) {
 	table.Render()
 }
 
+func printJSON(data interface{}) error {
+	if outputFlag == outputNDJSON {
+		return printNDJSON(data)
+	}
+	var b []byte
+	var err error
+	if compactFlag {
+		b, err = json.Marshal(data)
+	} else {
+		b, err = json.MarshalIndent(data, "", "  ")
+	}
+	if err != nil {
+		return fmt.Errorf("could not serialize output: %w", err)
+	}
+	fmt.Println(string(b))
+	return nil
+}
+
+// printNDJSON prints one compact JSON document per line: one per element when
+// data is a slice, or a single one otherwise.
+func printNDJSON(data interface{}) error {
+	encoder := json.NewEncoder(os.Stdout)
+	v := reflect.ValueOf(data)
+	if v.Kind() != reflect.Slice {
+		return encoder.Encode(data)
+	}
+	for i := 0; i < v.Len(); i++ {
+		if err := encoder.Encode(v.Index(i).Interface()); err != nil {
+			return fmt.Errorf("could not serialize output: %w", err)
+		}
+	}
+	return nil
+}
+
 func destroyDatabases(client *turso.Client, names []string) error {
 	if len(names) == 0 {
 		return nil
@@ -150,6 +202,7 @@ func destroyDatabases(client *turso.Client, names []string) error {
 	settings.PersistChanges()
 
 	var g errgroup.Group
+	g.SetLimit(flags.MaxConcurrency())
 
 	msg := "Destroying databases..."
 	if len(names) == 1 {
@@ -185,7 +238,7 @@ func destroyDatabases(client *turso.Client, names []string) error {
 
 func destroyDatabaseRegion(client *turso.Client, database, region string) error {
 	if !isValidLocation(client, region) {
-		return fmt.Errorf("location '%s' is not a valid one", region)
+		return invalidLocationError(client, region)
 	}
 
 	s := prompt.Spinner(fmt.Sprintf("Destroying location %s of database %s... ", internal.Emph(region), internal.Emph(database)))
@@ -208,6 +261,7 @@ func destroyDatabaseRegion(client *turso.Client, database, region string) error
 
 	primary, replicas := extractPrimary(instances)
 	g := errgroup.Group{}
+	g.SetLimit(flags.MaxConcurrency())
 	for i := range replicas {
 		replica := replicas[i]
 		g.Go(func() error { return deleteDatabaseInstance(client, db.Name, replica.Name) })
@@ -315,6 +369,10 @@ func fetchLatestVersion() (string, error) {
 	if err != nil {
 		return "", err
 	}
+	return fetchLatestVersionWith(client)
+}
+
+func fetchLatestVersionWith(client *turso.Client) (string, error) {
 	resp, err := client.Get("/releases/latest", nil)
 	if err != nil {
 		return "", err
@@ -0,0 +1,48 @@
+package cmd
+
+import (
+	"fmt"
+	"runtime"
+
+	"github.com/spf13/cobra"
+	"github.com/tursodatabase/turso-cli/internal"
+)
+
+func init() {
+	rootCmd.AddCommand(versionCmd)
+	addOutputFlag(versionCmd)
+}
+
+type versionInfo struct {
+	Version   string `json:"version"`
+	GoVersion string `json:"go_version"`
+	OS        string `json:"os"`
+	Arch      string `json:"arch"`
+}
+
+var versionCmd = &cobra.Command{
+	Use:               "version",
+	Short:             "Show the CLI version, with the Go version and platform it was built for",
+	Args:              cobra.NoArgs,
+	ValidArgsFunction: noFilesArg,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := validateOutputFlag(); err != nil {
+			return err
+		}
+		cmd.SilenceUsage = true
+
+		info := versionInfo{
+			Version:   version,
+			GoVersion: runtime.Version(),
+			OS:        runtime.GOOS,
+			Arch:      runtime.GOARCH,
+		}
+
+		if jsonOutput() {
+			return printJSON(info)
+		}
+
+		fmt.Printf("turso version %s (%s %s/%s)\n", internal.Emph(info.Version), info.GoVersion, info.OS, info.Arch)
+		return nil
+	},
+}
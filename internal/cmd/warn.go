@@ -0,0 +1,19 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/tursodatabase/turso-cli/internal"
+	"github.com/tursodatabase/turso-cli/internal/flags"
+)
+
+// warnf prints a warning to stderr, so that it doesn't mix with the output of
+// the command. Warnings are left out when the output is JSON, which is meant
+// for scripts, and with --quiet.
+func warnf(format string, args ...interface{}) {
+	if jsonOutput() || flags.Quiet() {
+		return
+	}
+	fmt.Fprintf(os.Stderr, "%s: %s\n", internal.Warn("Warning"), fmt.Sprintf(format, args...))
+}
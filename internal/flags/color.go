@@ -0,0 +1,40 @@
+package flags
+
+import (
+	"fmt"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+const (
+	ColorAlways = "always"
+	ColorAuto   = "auto"
+	ColorNever  = "never"
+)
+
+var colorFlag string
+
+func AddColor(cmd *cobra.Command) {
+	usage := "When to color the output: always, auto or never. auto colors it when writing to a terminal and NO_COLOR is not set."
+	cmd.PersistentFlags().StringVar(&colorFlag, "color", ColorAuto, usage)
+	cmd.RegisterFlagCompletionFunc("color", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return []string{ColorAlways, ColorAuto, ColorNever}, cobra.ShellCompDirectiveNoFileComp
+	})
+}
+
+// ApplyColor enables or disables colored output according to --color. With
+// auto, the color package already honors NO_COLOR and disables colors when
+// stdout isn't a terminal.
+func ApplyColor() error {
+	switch colorFlag {
+	case ColorAlways:
+		color.NoColor = false
+	case ColorNever:
+		color.NoColor = true
+	case ColorAuto:
+	default:
+		return fmt.Errorf("invalid --color value %s. Valid values are %s, %s and %s", colorFlag, ColorAlways, ColorAuto, ColorNever)
+	}
+	return nil
+}
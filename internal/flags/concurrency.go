@@ -0,0 +1,32 @@
+package flags
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+const DefaultMaxConcurrency = 8
+
+var maxConcurrencyFlag int
+
+func AddMaxConcurrency(cmd *cobra.Command) {
+	usage := "Maximum number of concurrent requests made by batch operations."
+	cmd.PersistentFlags().IntVar(&maxConcurrencyFlag, "max-concurrency", DefaultMaxConcurrency, usage)
+}
+
+func ValidateMaxConcurrency() error {
+	if maxConcurrencyFlag < 1 {
+		return fmt.Errorf("--max-concurrency must be at least 1, got %d", maxConcurrencyFlag)
+	}
+	return nil
+}
+
+// MaxConcurrency returns the limit of concurrent requests for batch
+// operations.
+func MaxConcurrency() int {
+	if maxConcurrencyFlag < 1 {
+		return DefaultMaxConcurrency
+	}
+	return maxConcurrencyFlag
+}
@@ -0,0 +1,25 @@
+package flags
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+const ENV_ORG = "TURSO_ORG"
+
+var orgFlag string
+
+func AddOrg(cmd *cobra.Command) {
+	usage := "Organization to run the command in. Overrides the one selected with 'turso org switch' and the " + ENV_ORG + " env var."
+	cmd.PersistentFlags().StringVar(&orgFlag, "org", "", usage)
+}
+
+// Org returns the organization selected for this invocation with --org or
+// TURSO_ORG, or an empty string if the configured one should be used.
+func Org() string {
+	if orgFlag != "" {
+		return orgFlag
+	}
+	return os.Getenv(ENV_ORG)
+}
@@ -0,0 +1,24 @@
+package flags
+
+import (
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+const DefaultProbeTimeout = 3 * time.Second
+
+var probeTimeoutFlag time.Duration
+
+func AddProbeTimeout(cmd *cobra.Command) {
+	usage := "Maximum time to spend finding the closest location before falling back to a default one."
+	cmd.PersistentFlags().DurationVar(&probeTimeoutFlag, "probe-timeout", DefaultProbeTimeout, usage)
+}
+
+// ProbeTimeout returns how long to wait for the closest location probe.
+func ProbeTimeout() time.Duration {
+	if probeTimeoutFlag <= 0 {
+		return DefaultProbeTimeout
+	}
+	return probeTimeoutFlag
+}
@@ -0,0 +1,16 @@
+package flags
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var quietFlag bool
+
+func AddQuietFlag(cmd *cobra.Command) {
+	usage := "Don't show spinners or other progress output. Results and errors are still printed."
+	cmd.PersistentFlags().BoolVar(&quietFlag, "quiet", false, usage)
+}
+
+func Quiet() bool {
+	return quietFlag
+}
@@ -0,0 +1,16 @@
+package flags
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var timingsFlag bool
+
+func AddTimingsFlag(cmd *cobra.Command) {
+	usage := "Print the time spent authenticating, listing, probing, creating and waiting to stderr after the command. Not printed with JSON output."
+	cmd.PersistentFlags().BoolVar(&timingsFlag, "timings", false, usage)
+}
+
+func Timings() bool {
+	return timingsFlag
+}
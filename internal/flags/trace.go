@@ -0,0 +1,16 @@
+package flags
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var traceFlag string
+
+func AddTraceFlag(cmd *cobra.Command) {
+	usage := "Write every API request and response, with tokens redacted, to this file as JSON lines."
+	cmd.PersistentFlags().StringVar(&traceFlag, "trace", "", usage)
+}
+
+func Trace() string {
+	return traceFlag
+}
@@ -0,0 +1,16 @@
+package flags
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var verboseFlag bool
+
+func AddVerboseFlag(cmd *cobra.Command) {
+	usage := "Show detailed information, including the request id of each API call."
+	cmd.PersistentFlags().BoolVar(&verboseFlag, "verbose", false, usage)
+}
+
+func Verbose() bool {
+	return verboseFlag
+}
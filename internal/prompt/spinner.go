@@ -8,6 +8,15 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 )
 
+// quiet hides all spinners, as set with SetQuiet.
+var quiet bool
+
+// SetQuiet makes spinners show nothing when q is true. Spinners also show
+// nothing when the terminal is not interactive, so they don't clutter logs.
+func SetQuiet(q bool) {
+	quiet = q
+}
+
 type spinner struct {
 	spinner   spn.Model
 	prefix    string
@@ -67,8 +76,7 @@ func (m *spinner) Text(t string) {
 }
 
 func (m *spinner) Start() {
-	if !isInteractive {
-		fmt.Println(m.View())
+	if quiet || !isInteractive {
 		return
 	}
 
@@ -80,6 +88,7 @@ func (m *spinner) Start() {
 		defer close(ch)
 		tea.NewProgram(m).Run()
 		if m.cancelled {
+			fmt.Fprintln(os.Stderr, "Aborted.")
 			os.Exit(130)
 		}
 	}()
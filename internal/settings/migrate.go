@@ -0,0 +1,92 @@
+package settings
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+)
+
+const versionKey = "version"
+
+// migrations[i] upgrades a settings file from version i to version i+1. Files
+// written before versioning was introduced are version 0.
+var migrations = []func(config map[string]interface{}){
+	// Tokens pasted into older versions were stored with surrounding
+	// whitespace.
+	func(config map[string]interface{}) {
+		if token, ok := config["token"].(string); ok {
+			config["token"] = strings.TrimSpace(token)
+		}
+	},
+	// Cache entries are now scoped by organization and can't be decoded in
+	// the old format. They are rebuilt on demand.
+	func(config map[string]interface{}) {
+		delete(config, "cache")
+	},
+}
+
+// currentVersion is the version of the settings files written by this CLI.
+var currentVersion = len(migrations)
+
+func configVersion(config map[string]interface{}) int {
+	switch version := config[versionKey].(type) {
+	case float64:
+		return int(version)
+	case int:
+		return version
+	default:
+		return 0
+	}
+}
+
+// migrateConfig upgrades config to currentVersion in place and reports
+// whether it changed. Configs written by a newer CLI are left untouched, as
+// their format is unknown.
+func migrateConfig(config map[string]interface{}) bool {
+	version := configVersion(config)
+	if version >= currentVersion {
+		return false
+	}
+	for _, migrate := range migrations[version:] {
+		migrate(config)
+	}
+	config[versionKey] = currentVersion
+	return true
+}
+
+// migrateFile upgrades the settings file at path, keeping a copy of the old
+// file next to it. It reports whether the file was changed.
+func migrateFile(path string) (bool, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return false, err
+	}
+	config := map[string]interface{}{}
+	if err := json.Unmarshal(data, &config); err != nil {
+		return false, err
+	}
+
+	version := configVersion(config)
+	if !migrateConfig(config) {
+		return false, nil
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return false, err
+	}
+	backup := fmt.Sprintf("%s.v%d.bak", path, version)
+	if err := os.WriteFile(backup, data, info.Mode().Perm()); err != nil {
+		return false, fmt.Errorf("could not back up settings file before upgrading it: %w", err)
+	}
+
+	upgraded, err := json.MarshalIndent(config, "", "  ")
+	if err != nil {
+		return false, err
+	}
+	if err := os.WriteFile(path, upgraded, info.Mode().Perm()); err != nil {
+		return false, fmt.Errorf("could not write upgraded settings file: %w", err)
+	}
+	return true, nil
+}
@@ -0,0 +1,96 @@
+package settings
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestMigrateConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  map[string]interface{}
+		want    map[string]interface{}
+		changed bool
+	}{
+		{
+			name:    "version 0 to 1 trims the token",
+			config:  map[string]interface{}{"token": " abc\n"},
+			want:    map[string]interface{}{"token": "abc", "version": currentVersion},
+			changed: true,
+		},
+		{
+			name:    "version 1 to 2 drops the cache",
+			config:  map[string]interface{}{"version": float64(1), "token": "abc", "cache": map[string]interface{}{"database_names": "x"}},
+			want:    map[string]interface{}{"version": currentVersion, "token": "abc"},
+			changed: true,
+		},
+		{
+			name:    "version 0 to current applies every migration",
+			config:  map[string]interface{}{"token": "abc ", "cache": map[string]interface{}{}, "username": "me"},
+			want:    map[string]interface{}{"version": currentVersion, "token": "abc", "username": "me"},
+			changed: true,
+		},
+		{
+			name:   "current version is unchanged",
+			config: map[string]interface{}{"version": float64(currentVersion), "token": "abc", "cache": map[string]interface{}{}},
+			want:   map[string]interface{}{"version": float64(currentVersion), "token": "abc", "cache": map[string]interface{}{}},
+		},
+		{
+			name:   "newer version is left untouched",
+			config: map[string]interface{}{"version": float64(currentVersion + 1), "token": " abc", "profiles": []interface{}{}},
+			want:   map[string]interface{}{"version": float64(currentVersion + 1), "token": " abc", "profiles": []interface{}{}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if changed := migrateConfig(tt.config); changed != tt.changed {
+				t.Errorf("migrateConfig() = %v, want %v", changed, tt.changed)
+			}
+			if !reflect.DeepEqual(tt.config, tt.want) {
+				t.Errorf("migrateConfig() config = %v, want %v", tt.config, tt.want)
+			}
+		})
+	}
+}
+
+func TestMigrateFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "settings.json")
+	old := []byte(`{"token": "abc\n", "cache": {"locations": {}}}`)
+	if err := os.WriteFile(path, old, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	migrated, err := migrateFile(path)
+	if err != nil || !migrated {
+		t.Fatalf("migrateFile() = %v, %v, want true, nil", migrated, err)
+	}
+
+	backup, err := os.ReadFile(path + ".v0.bak")
+	if err != nil {
+		t.Fatalf("backup was not written: %v", err)
+	}
+	if string(backup) != string(old) {
+		t.Errorf("backup = %s, want %s", backup, old)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var config map[string]interface{}
+	if err := json.Unmarshal(data, &config); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]interface{}{"token": "abc", "version": float64(currentVersion)}
+	if !reflect.DeepEqual(config, want) {
+		t.Errorf("migrated file = %v, want %v", config, want)
+	}
+
+	migrated, err = migrateFile(path)
+	if err != nil || migrated {
+		t.Errorf("second migrateFile() = %v, %v, want false, nil", migrated, err)
+	}
+}
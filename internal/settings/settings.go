@@ -5,6 +5,7 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"strings"
 	"sync"
 
 	"github.com/kirsle/configdir"
@@ -57,6 +58,7 @@ func ReadSettings() (*Settings, error) {
 		switch err.(type) {
 		case viper.ConfigFileNotFoundError:
 			// Force config creation
+			viper.Set(versionKey, currentVersion)
 			if err := viper.SafeWriteConfig(); err != nil {
 				return nil, err
 			}
@@ -67,15 +69,23 @@ func ReadSettings() (*Settings, error) {
 			}
 			warning := internal.Warn("Warning")
 			flag := internal.Emph("--reset-config")
-			fmt.Printf("%s: could not parse JSON config from file %s\n", warning, internal.Emph(configFile))
-			fmt.Printf("Fix the syntax errors on the file, or use the %s flag to replace it with a fresh one.\n", flag)
-			fmt.Printf("E.g. turso auth login --reset-config\n")
+			fmt.Fprintf(os.Stderr, "%s: could not parse JSON config from file %s\n", warning, internal.Emph(configFile))
+			fmt.Fprintf(os.Stderr, "Fix the syntax errors on the file, or use the %s flag to replace it with a fresh one.\n", flag)
+			fmt.Fprintf(os.Stderr, "E.g. turso auth login --reset-config\n")
 			return nil, err
 		default:
 			return nil, err
 		}
 	}
 
+	if migrated, err := migrateFile(configFile); err != nil {
+		fmt.Fprintf(os.Stderr, "%s: could not upgrade config file %s: %v\n", internal.Warn("Warning"), internal.Emph(configFile), err)
+	} else if migrated {
+		if err := viper.ReadInConfig(); err != nil {
+			return nil, err
+		}
+	}
+
 	return settings, nil
 }
 
@@ -122,12 +132,12 @@ func (s *Settings) Organization() string {
 }
 
 func (s *Settings) SetToken(token string) {
-	viper.Set("token", token)
+	viper.Set("token", strings.TrimSpace(token))
 	s.changed = true
 }
 
 func (s *Settings) GetToken() string {
-	return viper.GetString("token")
+	return strings.TrimSpace(viper.GetString("token"))
 }
 
 func (s *Settings) SetUsername(username string) {
@@ -191,3 +201,90 @@ func (s *Settings) GetAutoupdate() string {
 	value := config["autoupdate"]
 	return value.(string)
 }
+
+const locationGroupsKey = "location_groups"
+
+// LocationGroups returns the named sets of location IDs configured with turso
+// config location-groups.
+func (s *Settings) LocationGroups() map[string][]string {
+	groups := map[string][]string{}
+	for name, members := range viper.GetStringMap(locationGroupsKey) {
+		list, ok := members.([]interface{})
+		if !ok {
+			continue
+		}
+		locations := make([]string, 0, len(list))
+		for _, member := range list {
+			if location, ok := member.(string); ok {
+				locations = append(locations, location)
+			}
+		}
+		groups[name] = locations
+	}
+	return groups
+}
+
+func (s *Settings) SetLocationGroup(name string, locations []string) {
+	groups := viper.GetStringMap(locationGroupsKey)
+	members := make([]interface{}, 0, len(locations))
+	for _, location := range locations {
+		members = append(members, location)
+	}
+	groups[name] = members
+	viper.Set(locationGroupsKey, groups)
+	s.changed = true
+}
+
+func (s *Settings) DeleteLocationGroup(name string) bool {
+	groups := viper.GetStringMap(locationGroupsKey)
+	if _, ok := groups[name]; !ok {
+		return false
+	}
+	delete(groups, name)
+	viper.Set(locationGroupsKey, groups)
+	s.changed = true
+	return true
+}
+
+const defaultDatabaseKey = "default_database"
+
+// DefaultDatabase returns the database set with turso db config set-default,
+// or an empty string if there is none.
+func (s *Settings) DefaultDatabase() string {
+	return viper.GetString(defaultDatabaseKey)
+}
+
+func (s *Settings) SetDefaultDatabase(name string) {
+	viper.Set(defaultDatabaseKey, name)
+	s.changed = true
+}
+
+func (s *Settings) UnsetDefaultDatabase() bool {
+	if viper.GetString(defaultDatabaseKey) == "" {
+		return false
+	}
+	viper.Set(defaultDatabaseKey, "")
+	s.changed = true
+	return true
+}
+
+const databaseDescriptionsKey = "database_descriptions"
+
+// DatabaseDescription returns the description given to the database with id
+// with turso db describe, or an empty string if there is none.
+func (s *Settings) DatabaseDescription(id string) string {
+	return viper.GetStringMapString(databaseDescriptionsKey)[strings.ToLower(id)]
+}
+
+// SetDatabaseDescription sets the description of the database with id, or
+// removes it if description is empty.
+func (s *Settings) SetDatabaseDescription(id, description string) {
+	descriptions := viper.GetStringMapString(databaseDescriptionsKey)
+	if description == "" {
+		delete(descriptions, strings.ToLower(id))
+	} else {
+		descriptions[strings.ToLower(id)] = description
+	}
+	viper.Set(databaseDescriptionsKey, descriptions)
+	s.changed = true
+}
@@ -22,7 +22,7 @@ func (a *ApiTokensClient) List() ([]ApiToken, error) {
 	defer res.Body.Close()
 
 	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("failed to get api tokens list: %s", res.Status)
+		return nil, fmt.Errorf("failed to get api tokens list: %w", parseResponseError(res))
 	}
 
 	type ListResponse struct {
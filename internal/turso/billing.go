@@ -24,7 +24,7 @@ func (c *BillingClient) Portal() (Portal, error) {
 	defer r.Body.Close()
 
 	if r.StatusCode != 200 {
-		return Portal{}, fmt.Errorf("failed to get billing portal with status %s: %v", r.Status, parseResponseError(r))
+		return Portal{}, fmt.Errorf("failed to get billing portal with status %s: %w", r.Status, parseResponseError(r))
 	}
 
 	resp, err := unmarshal[struct{ Portal Portal }](r)
@@ -49,7 +49,7 @@ func (c *BillingClient) PortalForStripeId(stripeId string) (Portal, error) {
 	defer r.Body.Close()
 
 	if r.StatusCode != 200 {
-		return Portal{}, fmt.Errorf("failed to get billing portal with status %s: %v", r.Status, parseResponseError(r))
+		return Portal{}, fmt.Errorf("failed to get billing portal with status %s: %w", r.Status, parseResponseError(r))
 	}
 
 	resp, err := unmarshal[struct{ Portal Portal }](r)
@@ -68,7 +68,7 @@ func (c *BillingClient) HasPaymentMethod() (bool, error) {
 	defer r.Body.Close()
 
 	if r.StatusCode != 200 {
-		return false, fmt.Errorf("failed to check payment method with status %s: %v", r.Status, parseResponseError(r))
+		return false, fmt.Errorf("failed to check payment method with status %s: %w", r.Status, parseResponseError(r))
 	}
 
 	resp, err := unmarshal[struct{ Exists bool }](r)
@@ -84,7 +84,7 @@ func (c *BillingClient) HasPaymentMethodWithStripeId(stripeId string) (bool, err
 	defer r.Body.Close()
 
 	if r.StatusCode != 200 {
-		return false, fmt.Errorf("failed to check payment method with status %s: %v", r.Status, parseResponseError(r))
+		return false, fmt.Errorf("failed to check payment method with status %s: %w", r.Status, parseResponseError(r))
 	}
 
 	resp, err := unmarshal[struct{ Exists bool }](r)
@@ -106,7 +106,7 @@ func (c *BillingClient) CreateStripeCustomer(name string) (string, error) {
 	defer r.Body.Close()
 
 	if r.StatusCode != 200 {
-		return "", fmt.Errorf("failed to create stripe customer with status %s: %v", r.Status, parseResponseError(r))
+		return "", fmt.Errorf("failed to create stripe customer with status %s: %w", r.Status, parseResponseError(r))
 	}
 
 	resp, err := unmarshal[struct{ StripeCustomerId string }](r)
@@ -145,7 +145,7 @@ func (c *BillingClient) GetBillingCustomer() (BillingCustomer, error) {
 	defer r.Body.Close()
 
 	if r.StatusCode != 200 {
-		return BillingCustomer{}, fmt.Errorf("failed to get billing customer with status %s: %v", r.Status, parseResponseError(r))
+		return BillingCustomer{}, fmt.Errorf("failed to get billing customer with status %s: %w", r.Status, parseResponseError(r))
 	}
 
 	resp, err := unmarshal[BillingCustomer](r)
@@ -168,7 +168,7 @@ func (c *BillingClient) UpdateBillingCustomer(customer BillingCustomer) error {
 	defer r.Body.Close()
 
 	if r.StatusCode != 200 {
-		return fmt.Errorf("failed to update billing customer with status %s: %v", r.Status, parseResponseError(r))
+		return fmt.Errorf("failed to update billing customer with status %s: %w", r.Status, parseResponseError(r))
 	}
 
 	return nil
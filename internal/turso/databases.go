@@ -61,7 +61,7 @@ func (d *DatabasesClient) Delete(database string) error {
 	}
 
 	if r.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("database %s not found. List known databases using %s", internal.Emph(database), internal.Emph("turso db list"))
+		return newAPIError(r, fmt.Sprintf("database %s not found. List known databases using %s", internal.Emph(database), internal.Emph("turso db list")))
 	}
 
 	if r.StatusCode != http.StatusOK {
@@ -114,7 +114,7 @@ func (d *DatabasesClient) Create(name, location, image, extensions, group string
 	}
 
 	if res.StatusCode == http.StatusUnprocessableEntity {
-		return nil, fmt.Errorf("database name '%s' is not available", name)
+		return nil, newAPIError(res, fmt.Sprintf("database name '%s' is not available", name))
 	}
 
 	if res.StatusCode != http.StatusOK {
@@ -143,7 +143,7 @@ func (d *DatabasesClient) Seed(name string, dbFile *os.File) error {
 	}
 
 	if res.StatusCode == http.StatusUnprocessableEntity {
-		return fmt.Errorf("database name '%s' is not available", name)
+		return newAPIError(res, fmt.Sprintf("database name '%s' is not available", name))
 	}
 
 	if res.StatusCode != http.StatusOK {
@@ -301,15 +301,20 @@ func (d *DatabasesClient) Transfer(database, org string) error {
 	}
 	r, err := d.client.Post(url, bodyReader)
 	if err != nil {
-		return fmt.Errorf("failed to transfer database")
+		return fmt.Errorf("failed to transfer database: %w", err)
 	}
 	defer r.Body.Close()
 
-	if r.StatusCode != http.StatusOK {
+	switch r.StatusCode {
+	case http.StatusOK:
+		return nil
+	case http.StatusNotFound:
+		return newAPIError(r, fmt.Sprintf("failed to transfer %s database: database or organization %s not found", database, org))
+	case http.StatusForbidden:
+		return newAPIError(r, fmt.Sprintf("failed to transfer %s database to org %s: permission denied, make sure you are allowed to manage databases in both organizations", database, org))
+	default:
 		return fmt.Errorf("failed to transfer %s database to org %s: %w", database, org, parseResponseError(r))
 	}
-
-	return nil
 }
 
 func (d *DatabasesClient) Wakeup(database string) error {
@@ -401,7 +406,7 @@ func (d *DatabasesClient) GetConfig(database string) (DatabaseConfig, error) {
 
 	if r.StatusCode != http.StatusOK {
 		err = parseResponseError(r)
-		return DatabaseConfig{}, fmt.Errorf("failed to get config for database: %d %s", r.StatusCode, err)
+		return DatabaseConfig{}, fmt.Errorf("failed to get config for database: %w", err)
 	}
 
 	return unmarshal[DatabaseConfig](r)
@@ -426,7 +431,7 @@ func (d *DatabasesClient) UpdateConfig(database string, config DatabaseConfig) e
 
 	if r.StatusCode != http.StatusOK {
 		err = parseResponseError(r)
-		return fmt.Errorf("failed to update config for database: %d %s", r.StatusCode, err)
+		return fmt.Errorf("failed to update config for database: %w", err)
 	}
 
 	return nil
@@ -0,0 +1,55 @@
+package turso
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// APIError is returned by the client methods when the platform API responds
+// with an unexpected status code.
+type APIError struct {
+	StatusCode int
+	Message    string
+	RequestID  string
+}
+
+func (e *APIError) Error() string {
+	if e.RequestID == "" {
+		return e.Message
+	}
+	return fmt.Sprintf("%s (request id: %s)", e.Message, e.RequestID)
+}
+
+// IsRetryable reports whether the request may succeed if sent again, because
+// the failure was caused by rate limiting or a transient server problem.
+func (e *APIError) IsRetryable() bool {
+	switch e.StatusCode {
+	case http.StatusRequestTimeout,
+		http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsRetryable reports whether err, or any error it wraps, is a retryable
+// APIError.
+func IsRetryable(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.IsRetryable()
+}
+
+// StatusCode returns the status code of the APIError wrapped by err, or 0 if
+// there is none.
+func StatusCode(err error) int {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode
+	}
+	return 0
+}
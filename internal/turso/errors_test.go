@@ -0,0 +1,92 @@
+package turso
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestAPIErrorClassification(t *testing.T) {
+	tests := []struct {
+		name      string
+		err       error
+		retryable bool
+		status    int
+	}{
+		{name: "nil", err: nil},
+		{name: "not an api error", err: errors.New("boom")},
+		{name: "bad request", err: &APIError{StatusCode: http.StatusBadRequest}, status: http.StatusBadRequest},
+		{name: "not found", err: &APIError{StatusCode: http.StatusNotFound}, status: http.StatusNotFound},
+		{name: "request timeout", err: &APIError{StatusCode: http.StatusRequestTimeout}, retryable: true, status: http.StatusRequestTimeout},
+		{name: "rate limited", err: &APIError{StatusCode: http.StatusTooManyRequests}, retryable: true, status: http.StatusTooManyRequests},
+		{name: "internal error", err: &APIError{StatusCode: http.StatusInternalServerError}, retryable: true, status: http.StatusInternalServerError},
+		{name: "bad gateway", err: &APIError{StatusCode: http.StatusBadGateway}, retryable: true, status: http.StatusBadGateway},
+		{name: "unavailable", err: &APIError{StatusCode: http.StatusServiceUnavailable}, retryable: true, status: http.StatusServiceUnavailable},
+		{name: "gateway timeout", err: &APIError{StatusCode: http.StatusGatewayTimeout}, retryable: true, status: http.StatusGatewayTimeout},
+		{name: "not implemented", err: &APIError{StatusCode: http.StatusNotImplemented}, status: http.StatusNotImplemented},
+		{name: "wrapped", err: fmt.Errorf("failed to get location ams: %w", &APIError{StatusCode: http.StatusServiceUnavailable}), retryable: true, status: http.StatusServiceUnavailable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsRetryable(tt.err); got != tt.retryable {
+				t.Errorf("IsRetryable() = %v, want %v", got, tt.retryable)
+			}
+			if got := StatusCode(tt.err); got != tt.status {
+				t.Errorf("StatusCode() = %d, want %d", got, tt.status)
+			}
+		})
+	}
+}
+
+func TestClientErrorsCarryStatusCode(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req-1")
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = w.Write([]byte(`{"error": "try again later"}`))
+	}))
+	defer server.Close()
+
+	base, _ := url.Parse(server.URL)
+	client := New(base, "token", "dev", "")
+	calls := []struct {
+		name string
+		call func() error
+	}{
+		{name: "instances list", call: func() error { _, err := client.Instances.List("db"); return err }},
+		{name: "instances delete", call: func() error { return client.Instances.Delete("db", "replica") }},
+		{name: "location get", call: func() error { _, err := client.Locations.Get("ams"); return err }},
+		{name: "database transfer", call: func() error { return client.Databases.Transfer("db", "other") }},
+		{name: "api tokens list", call: func() error { _, err := client.ApiTokens.List(); return err }},
+	}
+	for _, tt := range calls {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.call()
+			if StatusCode(err) != http.StatusServiceUnavailable || !IsRetryable(err) {
+				t.Errorf("expected a retryable error with status 503, got %v", err)
+			}
+		})
+	}
+}
+
+func TestTransferErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	base, _ := url.Parse(server.URL)
+	client := New(base, "token", "dev", "")
+
+	err := client.Databases.Transfer("db", "other")
+	if StatusCode(err) != http.StatusForbidden || !strings.Contains(err.Error(), "permission denied") {
+		t.Errorf("expected a permission denied error with status 403, got %v", err)
+	}
+
+	server.Close()
+	var netErr *NetworkError
+	if err := client.Databases.Transfer("db", "other"); !errors.As(err, &netErr) {
+		t.Errorf("expected the network error to be wrapped, got %v", err)
+	}
+}
@@ -30,7 +30,7 @@ func (d *GroupsClient) List() ([]Group, error) {
 	}
 
 	if r.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("failed to get database groups: received status code%w", parseResponseError(r))
+		return nil, fmt.Errorf("failed to get database groups: %w", parseResponseError(r))
 	}
 
 	type ListResponse struct {
@@ -53,11 +53,11 @@ func (d *GroupsClient) Get(name string) (Group, error) {
 	}
 
 	if r.StatusCode == http.StatusNotFound {
-		return Group{}, fmt.Errorf("group %s was not found", name)
+		return Group{}, newAPIError(r, fmt.Sprintf("group %s was not found", name))
 	}
 
 	if r.StatusCode != http.StatusOK {
-		return Group{}, fmt.Errorf("failed to get database group: received status code%w", parseResponseError(r))
+		return Group{}, fmt.Errorf("failed to get database group: %w", parseResponseError(r))
 	}
 
 	type Response struct {
@@ -81,11 +81,11 @@ func (d *GroupsClient) Delete(group string) error {
 	}
 
 	if r.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("group %s not found. List known databases using %s", internal.Emph(group), internal.Emph("turso group list"))
+		return newAPIError(r, fmt.Sprintf("group %s not found. List known databases using %s", internal.Emph(group), internal.Emph("turso group list")))
 	}
 
 	if r.StatusCode != http.StatusOK {
-		return fmt.Errorf("failed to delete group: received status code%w", parseResponseError(r))
+		return fmt.Errorf("failed to delete group: %w", parseResponseError(r))
 	}
 
 	return nil
@@ -110,7 +110,7 @@ func (d *GroupsClient) Create(name, location, version string) error {
 	}
 
 	if res.StatusCode == http.StatusUnprocessableEntity {
-		return fmt.Errorf("group name '%s' is not available", name)
+		return newAPIError(res, fmt.Sprintf("group name '%s' is not available", name))
 	}
 
 	if res.StatusCode != http.StatusOK {
@@ -1,7 +1,6 @@
 package turso
 
 import (
-	"errors"
 	"fmt"
 	"net/http"
 )
@@ -37,7 +36,7 @@ func (i *InstancesClient) List(db string) ([]Instance, error) {
 	}
 
 	if r.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("response with status code %d", r.StatusCode)
+		return nil, parseResponseError(r)
 	}
 
 	type ListResponse struct{ Instances []Instance }
@@ -64,26 +63,27 @@ func (i *InstancesClient) Delete(db, instance string) error {
 
 	if r.StatusCode == http.StatusBadRequest {
 		body, _ := unmarshal[struct{ Error string }](r)
-		return errors.New(body.Error)
+		return newAPIError(r, body.Error)
 	}
 
 	if r.StatusCode == http.StatusNotFound {
 		body, _ := unmarshal[struct{ Error string }](r)
-		return errors.New(body.Error)
+		return newAPIError(r, body.Error)
 	}
 
 	if r.StatusCode != http.StatusOK {
-		return fmt.Errorf("response with status code %d", r.StatusCode)
+		return parseResponseError(r)
 	}
 
 	return nil
 }
 
-func (d *InstancesClient) Create(dbName, location string) (*Instance, error) {
+func (d *InstancesClient) Create(dbName, location, image string) (*Instance, error) {
 	type Body struct {
 		Location string
+		Image    string `json:",omitempty"`
 	}
-	body, err := marshal(Body{location})
+	body, err := marshal(Body{location, image})
 	if err != nil {
 		return nil, fmt.Errorf("could not serialize request body: %w", err)
 	}
@@ -108,12 +108,21 @@ func (d *InstancesClient) Create(dbName, location string) (*Instance, error) {
 		return nil, parseResponseError(res)
 	}
 
-	data, err := unmarshal[struct{ Instance Instance }](res)
+	data, err := unmarshal[struct {
+		Instance *Instance
+		Error    string
+	}](res)
 	if err != nil {
 		return nil, fmt.Errorf("failed to deserialize response: %w", err)
 	}
+	if data.Error != "" {
+		return nil, fmt.Errorf("failed to create new instance: %s", data.Error)
+	}
+	if data.Instance == nil || data.Instance.Name == "" {
+		return nil, fmt.Errorf("failed to create new instance: the response doesn't describe the new instance")
+	}
 
-	return &data.Instance, nil
+	return data.Instance, nil
 }
 
 func (i *InstancesClient) Wait(db, instance string) error {
@@ -131,16 +140,16 @@ func (i *InstancesClient) Wait(db, instance string) error {
 
 	if r.StatusCode == http.StatusBadRequest {
 		body, _ := unmarshal[struct{ Error string }](r)
-		return errors.New(body.Error)
+		return newAPIError(r, body.Error)
 	}
 
 	if r.StatusCode == http.StatusNotFound {
 		body, _ := unmarshal[struct{ Error string }](r)
-		return errors.New(body.Error)
+		return newAPIError(r, body.Error)
 	}
 
 	if r.StatusCode != http.StatusOK {
-		return fmt.Errorf("response with status code %d", r.StatusCode)
+		return parseResponseError(r)
 	}
 
 	return nil
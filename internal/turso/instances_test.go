@@ -0,0 +1,48 @@
+package turso
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestCreateInstanceResponse(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		err  string
+	}{
+		{name: "valid", body: `{"instance": {"uuid": "1", "name": "replica", "type": "replica", "region": "ams"}}`},
+		{name: "error body", body: `{"error": "location is at capacity"}`, err: "location is at capacity"},
+		{name: "missing instance", body: `{}`, err: "doesn't describe the new instance"},
+		{name: "null instance", body: `{"instance": null}`, err: "doesn't describe the new instance"},
+		{name: "wrong shape", body: `{"instance": "replica"}`, err: "failed to deserialize response"},
+		{name: "not json", body: `<html>bad gateway</html>`, err: "failed to deserialize response"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			base, _ := url.Parse(server.URL)
+			client := New(base, "token", "dev", "")
+			instance, err := client.Instances.Create("db", "ams", "")
+			if tt.err == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if instance.Name != "replica" || instance.Region != "ams" {
+					t.Fatalf("unexpected instance: %+v", instance)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Fatalf("expected error containing %q, got %v", tt.err, err)
+			}
+		})
+	}
+}
@@ -29,7 +29,7 @@ func (i *InvoicesClient) List() ([]Invoice, error) {
 	}
 
 	if r.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("failed to get invoices: received status code%w", parseResponseError(r))
+		return nil, fmt.Errorf("failed to get invoices: %w", parseResponseError(r))
 	}
 
 	type ListResponse struct {
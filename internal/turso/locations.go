@@ -1,6 +1,7 @@
 package turso
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"regexp"
@@ -8,6 +9,7 @@ import (
 	"unicode/utf8"
 
 	"github.com/rodaine/table"
+	"golang.org/x/exp/maps"
 )
 
 type LocationsClient client
@@ -27,7 +29,22 @@ type LocationResponse struct {
 	Closest     []Location
 }
 
+// List returns the description of each location by ID. The response is
+// memoized, so later calls on the same client don't hit the API.
 func (c *LocationsClient) List() (map[string]string, error) {
+	c.client.locationsMu.Lock()
+	defer c.client.locationsMu.Unlock()
+	if c.client.locations == nil {
+		locations, err := c.list()
+		if err != nil {
+			return nil, err
+		}
+		c.client.locations = locations
+	}
+	return maps.Clone(c.client.locations), nil
+}
+
+func (c *LocationsClient) list() (map[string]string, error) {
 	r, err := c.client.Get("/v1/locations", nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to request locations: %s", err)
@@ -55,7 +72,7 @@ func (c *LocationsClient) Get(location string) (LocationResponse, error) {
 	defer r.Body.Close()
 
 	if r.StatusCode != http.StatusOK {
-		return LocationResponse{}, fmt.Errorf("failed to get location %s: %s", location, r.Status)
+		return LocationResponse{}, fmt.Errorf("failed to get location %s: %w", location, parseResponseError(r))
 	}
 
 	data, err := unmarshal[struct {
@@ -73,8 +90,20 @@ type ClosestLocationResponse struct {
 	Server string
 }
 
-func (c *LocationsClient) Closest() (string, error) {
-	r, err := c.client.Get("https://region.turso.io", nil)
+// closestLocationURL answers with the location of the server that handled the
+// request, which is the closest one to the client.
+var closestLocationURL = "https://region.turso.io"
+
+// Closest returns the location closest to the client, giving up after
+// timeout so that a slow probe doesn't stall the command.
+func (c *LocationsClient) Closest(timeout time.Duration) (string, error) {
+	req, err := c.client.newRequest("GET", closestLocationURL, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to request closest: %s", err)
+	}
+	ctx, cancel := context.WithTimeout(req.Context(), timeout)
+	defer cancel()
+	r, err := c.client.send(req.WithContext(ctx))
 	if err != nil {
 		return "", fmt.Errorf("failed to request closest: %s", err)
 	}
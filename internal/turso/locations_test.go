@@ -0,0 +1,58 @@
+package turso
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestLocationsListIsMemoized(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		_, _ = w.Write([]byte(`{"locations": {"ams": "Amsterdam, Netherlands", "gru": "São Paulo, Brazil"}}`))
+	}))
+	defer server.Close()
+
+	base, _ := url.Parse(server.URL)
+	client := New(base, "token", "dev", "")
+	for i := 0; i < 3; i++ {
+		locations, err := client.Locations.List()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(locations) != 2 {
+			t.Fatalf("expected 2 locations, got %v", locations)
+		}
+		delete(locations, "ams")
+	}
+	if requests != 1 {
+		t.Fatalf("expected 1 request, got %d", requests)
+	}
+}
+
+func TestClosestGivesUpAfterTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+		_, _ = w.Write([]byte(`{"server": "ams"}`))
+	}))
+	defer server.Close()
+
+	defer func(url string) { closestLocationURL = url }(closestLocationURL)
+	closestLocationURL = server.URL
+
+	base, _ := url.Parse(server.URL)
+	client := New(base, "token", "dev", "")
+	start := time.Now()
+	if _, err := client.Locations.Closest(50 * time.Millisecond); err == nil {
+		t.Fatal("expected the probe to time out")
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("expected the probe to give up quickly, took %s", elapsed)
+	}
+}
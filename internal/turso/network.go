@@ -0,0 +1,86 @@
+package turso
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/tursodatabase/turso-cli/internal/flags"
+)
+
+// requestProxy returns the proxy used for a request, the same way the default
+// transport picks it from HTTPS_PROXY, HTTP_PROXY and NO_PROXY.
+var requestProxy = http.ProxyFromEnvironment
+
+const lookupTimeout = 3 * time.Second
+
+const noInternetReason = "there seems to be no internet connection. Check your network and try again."
+
+// NetworkError is returned when a request doesn't reach the API, with the
+// likely reason found from the error and a DNS lookup of the API host.
+type NetworkError struct {
+	Host   string
+	Reason string
+	Err    error
+}
+
+func (e *NetworkError) Error() string {
+	msg := fmt.Sprintf("could not reach the Turso API at %s: %s", e.Host, e.Reason)
+	if flags.Verbose() {
+		return fmt.Sprintf("%s\nCause: %v", msg, e.Err)
+	}
+	return msg + "\nRun the command again with --verbose to see the underlying error."
+}
+
+func (e *NetworkError) Unwrap() error {
+	return e.Err
+}
+
+// diagnoseNetworkError returns a NetworkError explaining why req failed with
+// err when it is a network error, or err unchanged otherwise.
+func diagnoseNetworkError(req *http.Request, err error) error {
+	if req.Context().Err() != nil {
+		return err
+	}
+	var netErr net.Error
+	if !errors.As(err, &netErr) {
+		return err
+	}
+
+	host := req.URL.Hostname()
+	if proxy, proxyErr := requestProxy(req); proxyErr == nil && proxy != nil {
+		// The proxy resolves and dials the API host, so what failed is
+		// reaching the proxy.
+		reason := fmt.Sprintf("the proxy %s is not answering. Check the HTTPS_PROXY and HTTP_PROXY environment variables.", proxy.Host)
+		return &NetworkError{Host: host, Reason: reason, Err: err}
+	}
+
+	var dnsErr *net.DNSError
+	switch {
+	case errors.As(err, &dnsErr) && dnsErr.IsNotFound:
+		return &NetworkError{Host: host, Reason: "the host name could not be resolved. Check your DNS settings, or the URL if you set TURSO_API_BASEURL.", Err: err}
+	case errors.As(err, &dnsErr):
+		return &NetworkError{Host: host, Reason: noInternetReason, Err: err}
+	case errors.Is(err, syscall.ENETUNREACH) || errors.Is(err, syscall.EHOSTUNREACH):
+		return &NetworkError{Host: host, Reason: noInternetReason, Err: err}
+	}
+
+	if net.ParseIP(host) == nil {
+		ctx, cancel := context.WithTimeout(context.Background(), lookupTimeout)
+		defer cancel()
+		addrs, lookupErr := net.DefaultResolver.LookupHost(ctx, host)
+		if lookupErr != nil {
+			return &NetworkError{Host: host, Reason: noInternetReason, Err: err}
+		}
+		host = fmt.Sprintf("%s (%s)", host, strings.Join(addrs, ", "))
+	}
+	if netErr.Timeout() {
+		return &NetworkError{Host: host, Reason: "the host did not answer in time. Check your network, or try again in a few moments if the API is down.", Err: err}
+	}
+	return &NetworkError{Host: host, Reason: "the host is not answering, the API may be down. Try again in a few moments.", Err: err}
+}
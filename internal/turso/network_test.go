@@ -0,0 +1,79 @@
+package turso
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"syscall"
+	"testing"
+)
+
+func TestNetworkErrorDiagnosis(t *testing.T) {
+	closed, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	closedAddr := closed.Addr().String()
+	closed.Close()
+
+	base, _ := url.Parse("http://" + closedAddr)
+	client := New(base, "token", "dev", "")
+	_, err = client.Get("/v1/locations", nil)
+	var netErr *NetworkError
+	if !errors.As(err, &netErr) || !strings.Contains(err.Error(), "API may be down") {
+		t.Fatalf("expected the API to be reported down, got %v", err)
+	}
+	if netErr.Host != "127.0.0.1" || netErr.Err == nil {
+		t.Errorf("unexpected network error %+v", netErr)
+	}
+}
+
+func TestNetworkErrorReasons(t *testing.T) {
+	dial := func(err error) error {
+		return &url.Error{Op: "Get", URL: "http://127.0.0.1/v1/locations", Err: &net.OpError{Op: "dial", Net: "tcp", Err: err}}
+	}
+	tests := []struct {
+		name   string
+		err    error
+		proxy  string
+		reason string
+	}{
+		{name: "not a network error", err: errors.New("boom")},
+		{name: "unknown host", err: dial(&net.DNSError{Err: "no such host", Name: "api.example", IsNotFound: true}), reason: "could not be resolved"},
+		{name: "no resolver", err: dial(&net.DNSError{Err: "server misbehaving", Name: "api.example", IsTemporary: true}), reason: "no internet connection"},
+		{name: "network unreachable", err: dial(os.NewSyscallError("connect", syscall.ENETUNREACH)), reason: "no internet connection"},
+		{name: "refused", err: dial(os.NewSyscallError("connect", syscall.ECONNREFUSED)), reason: "API may be down"},
+		{name: "proxy", err: dial(os.NewSyscallError("connect", syscall.ECONNREFUSED)), proxy: "http://proxy.internal:3128", reason: "proxy proxy.internal:3128 is not answering"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			proxy := requestProxy
+			defer func() { requestProxy = proxy }()
+			requestProxy = func(*http.Request) (*url.URL, error) {
+				if tt.proxy == "" {
+					return nil, nil
+				}
+				return url.Parse(tt.proxy)
+			}
+
+			req, _ := http.NewRequest(http.MethodGet, "http://127.0.0.1/v1/locations", nil)
+			err := diagnoseNetworkError(req, tt.err)
+			if tt.reason == "" {
+				if err != tt.err {
+					t.Fatalf("expected the error unchanged, got %v", err)
+				}
+				return
+			}
+			var netErr *NetworkError
+			if !errors.As(err, &netErr) || !strings.Contains(netErr.Reason, tt.reason) {
+				t.Fatalf("expected a reason containing %q, got %v", tt.reason, err)
+			}
+			if !errors.Is(err, tt.err) {
+				t.Errorf("expected the original error to be wrapped, got %v", netErr.Err)
+			}
+		})
+	}
+}
@@ -54,11 +54,11 @@ func (c *OrganizationsClient) Create(name string, stripeId string, dryRun bool)
 	defer r.Body.Close()
 
 	if r.StatusCode == http.StatusConflict {
-		return Organization{}, fmt.Errorf("failed to create organization %s: name already exists", internal.Emph(name))
+		return Organization{}, newAPIError(r, fmt.Sprintf("failed to create organization %s: name already exists", internal.Emph(name)))
 	}
 
 	if r.StatusCode == http.StatusPaymentRequired {
-		return Organization{}, fmt.Errorf("failed to create organization %s: you need to upgrade your plan", internal.Emph(name))
+		return Organization{}, newAPIError(r, fmt.Sprintf("failed to create organization %s: you need to upgrade your plan", internal.Emph(name)))
 	}
 
 	if r.StatusCode != http.StatusOK {
@@ -81,7 +81,7 @@ func (c *OrganizationsClient) Delete(slug string) error {
 	defer r.Body.Close()
 
 	if r.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("could not find organization %s", slug)
+		return newAPIError(r, fmt.Sprintf("could not find organization %s", slug))
 	}
 
 	switch r.StatusCode {
@@ -90,7 +90,7 @@ func (c *OrganizationsClient) Delete(slug string) error {
 	case http.StatusBadRequest:
 		return parseResponseError(r)
 	case http.StatusForbidden:
-		return fmt.Errorf("you do not have permission to delete organization %s", slug)
+		return newAPIError(r, fmt.Sprintf("you do not have permission to delete organization %s", slug))
 	default:
 		return fmt.Errorf("failed to delete organization: %w", parseResponseError(r))
 	}
@@ -129,8 +129,7 @@ func (c *OrganizationsClient) Usage() (OrgUsage, error) {
 	defer r.Body.Close()
 
 	if r.StatusCode != http.StatusOK {
-		err, _ := unmarshal[string](r)
-		return OrgUsage{}, fmt.Errorf("failed to get database usage: %d %s", r.StatusCode, err)
+		return OrgUsage{}, fmt.Errorf("failed to get database usage: %w", parseResponseError(r))
 	}
 
 	body, err := unmarshal[OrgUsageResponse](r)
@@ -140,6 +139,43 @@ func (c *OrganizationsClient) Usage() (OrgUsage, error) {
 	return body.OrgUsage, nil
 }
 
+type AuditLog struct {
+	Code      string                 `json:"code"`
+	Message   string                 `json:"message"`
+	Origin    string                 `json:"origin"`
+	Author    string                 `json:"author"`
+	CreatedAt string                 `json:"created_at"`
+	Data      map[string]interface{} `json:"data"`
+}
+
+// AuditLogs returns the most recent operations done in the organization,
+// newest first.
+func (c *OrganizationsClient) AuditLogs(pageSize int) ([]AuditLog, error) {
+	prefix := "/v1"
+	if c.client.Org != "" {
+		prefix = "/v1/organizations/" + c.client.Org
+	}
+
+	r, err := c.client.Get(fmt.Sprintf("%s/audit-logs?page_size=%d", prefix, pageSize), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get audit logs: %w", err)
+	}
+	defer r.Body.Close()
+
+	if r.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get audit logs: %w", parseResponseError(r))
+	}
+
+	type AuditLogsResponse struct {
+		AuditLogs []AuditLog `json:"audit_logs"`
+	}
+	body, err := unmarshal[AuditLogsResponse](r)
+	if err != nil {
+		return nil, err
+	}
+	return body.AuditLogs, nil
+}
+
 func (c *OrganizationsClient) SetOverages(slug string, toggle bool) error {
 	path := "/v1/organizations/" + slug
 	body, err := marshal(map[string]bool{"overages": toggle})
@@ -183,7 +219,7 @@ func (c *OrganizationsClient) ListMembers() ([]Member, error) {
 	defer r.Body.Close()
 
 	if r.StatusCode == http.StatusForbidden {
-		return nil, fmt.Errorf("only organization admins or owners can list members")
+		return nil, newAPIError(r, "only organization admins or owners can list members")
 	}
 
 	if r.StatusCode != http.StatusOK {
@@ -216,7 +252,7 @@ func (c *OrganizationsClient) AddMember(username, role string) error {
 	defer r.Body.Close()
 
 	if r.StatusCode == http.StatusForbidden {
-		return fmt.Errorf("only organization admins or owners can add members")
+		return newAPIError(r, "only organization admins or owners can add members")
 	}
 
 	if r.StatusCode != http.StatusOK {
@@ -241,7 +277,7 @@ func (c *OrganizationsClient) InviteMember(email, role string) error {
 	defer r.Body.Close()
 
 	if r.StatusCode == http.StatusForbidden {
-		return fmt.Errorf("only organization admins or owners can invite members")
+		return newAPIError(r, "only organization admins or owners can invite members")
 	}
 
 	if r.StatusCode != http.StatusOK {
@@ -261,11 +297,11 @@ func (c *OrganizationsClient) DeleteInvite(email string) error {
 	defer r.Body.Close()
 
 	if r.StatusCode == http.StatusForbidden {
-		return fmt.Errorf("only organization admins or owners can invite members")
+		return newAPIError(r, "only organization admins or owners can invite members")
 	}
 
 	if r.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("invite for %s not found", email)
+		return newAPIError(r, fmt.Sprintf("invite for %s not found", email))
 	}
 
 	if r.StatusCode != http.StatusOK {
@@ -285,7 +321,7 @@ func (c *OrganizationsClient) ListInvites() ([]Invite, error) {
 	defer r.Body.Close()
 
 	if r.StatusCode == http.StatusForbidden {
-		return []Invite{}, fmt.Errorf("only organization admins or owners can list invites")
+		return []Invite{}, newAPIError(r, "only organization admins or owners can list invites")
 	}
 
 	if r.StatusCode != http.StatusOK {
@@ -315,7 +351,7 @@ func (c *OrganizationsClient) RemoveMember(username string) error {
 	defer r.Body.Close()
 
 	if r.StatusCode == http.StatusForbidden {
-		return fmt.Errorf("only organization admins or owners can remove members")
+		return newAPIError(r, "only organization admins or owners can remove members")
 	}
 
 	if r.StatusCode != http.StatusOK {
@@ -329,24 +365,30 @@ func (c *OrganizationsClient) MembersURL(suffix string) (string, error) {
 	return "/v1/organizations/" + c.client.Org + "/members" + suffix, nil
 }
 
-func unsetOrganization() error {
+// unsetOrganization switches back to the personal organization if org is the
+// configured one. Organizations selected with --org are left alone.
+func unsetOrganization(org string) error {
 	settings, err := settings.ReadSettings()
 	if err != nil {
 		return err
 	}
-	settings.SetOrganization("")
+	if settings.Organization() == org {
+		settings.SetOrganization("")
+	}
 	return nil
 }
 
 func isNotMemberErr(status int, org string) bool {
-	if status == http.StatusForbidden && org != "" && unsetOrganization() == nil {
+	if status == http.StatusForbidden && org != "" && unsetOrganization(org) == nil {
 		return true
 	}
 	return false
 }
 
 func notMemberErr(org string) error {
-	msg := fmt.Sprintf("you are not a member of organization %s. ", internal.Emph(org))
-	msg += fmt.Sprintf("%s is now configured to use your personal organization.", internal.Emph("turso"))
-	return fmt.Errorf(msg)
+	msg := fmt.Sprintf("you are not a member of organization %s.", internal.Emph(org))
+	if settings, err := settings.ReadSettings(); err == nil && settings.Organization() == "" {
+		msg += fmt.Sprintf(" %s is now configured to use your personal organization.", internal.Emph("turso"))
+	}
+	return &APIError{StatusCode: http.StatusForbidden, Message: msg}
 }
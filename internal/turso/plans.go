@@ -30,7 +30,7 @@ func (c *PlansClient) List() ([]Plan, error) {
 	defer r.Body.Close()
 
 	if r.StatusCode != 200 {
-		return nil, fmt.Errorf("failed to list plans with status %s: %v", r.Status, parseResponseError(r))
+		return nil, fmt.Errorf("failed to list plans with status %s: %w", r.Status, parseResponseError(r))
 	}
 
 	resp, err := unmarshal[struct{ Plans []Plan }](r)
@@ -58,7 +58,7 @@ func (c *SubscriptionClient) Get() (Subscription, error) {
 	defer r.Body.Close()
 
 	if r.StatusCode != 200 {
-		return Subscription{}, fmt.Errorf("failed to get organization plan with status %s: %v", r.Status, parseResponseError(r))
+		return Subscription{}, fmt.Errorf("failed to get organization plan with status %s: %w", r.Status, parseResponseError(r))
 	}
 
 	resp, err := unmarshal[struct{ Subscription Subscription }](r)
@@ -93,7 +93,7 @@ func (c *SubscriptionClient) Update(plan, timeline string, overages *bool) error
 	}
 
 	if r.StatusCode != 200 {
-		return fmt.Errorf("failed to set organization plan with status %s: %v", r.Status, parseResponseError(r))
+		return fmt.Errorf("failed to set organization plan with status %s: %w", r.Status, parseResponseError(r))
 	}
 
 	return nil
@@ -0,0 +1,120 @@
+package turso
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// httpClient sends the requests of all clients. TraceTo replaces it with one
+// that records them.
+var httpClient = http.DefaultClient
+
+// TraceTo makes all API requests be written to w, one JSON object per line.
+func TraceTo(w io.Writer) {
+	httpClient = &http.Client{Transport: &tracingTransport{next: http.DefaultTransport, w: w}}
+}
+
+type traceEntry struct {
+	Time            string      `json:"time"`
+	Method          string      `json:"method"`
+	URL             string      `json:"url"`
+	RequestHeaders  http.Header `json:"request_headers"`
+	RequestBody     string      `json:"request_body,omitempty"`
+	Status          int         `json:"status,omitempty"`
+	ResponseHeaders http.Header `json:"response_headers,omitempty"`
+	ResponseBody    string      `json:"response_body,omitempty"`
+	DurationMs      float64     `json:"duration_ms"`
+	Error           string      `json:"error,omitempty"`
+}
+
+type tracingTransport struct {
+	next http.RoundTripper
+	mu   sync.Mutex
+	w    io.Writer
+}
+
+func (t *tracingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	entry := traceEntry{
+		Time:           start.UTC().Format(time.RFC3339Nano),
+		Method:         req.Method,
+		URL:            req.URL.String(),
+		RequestHeaders: redactHeaders(req.Header),
+	}
+
+	if strings.HasPrefix(req.Header.Get("Content-Type"), "multipart/") {
+		// Uploads can be gigabytes long, so they are left out.
+		entry.RequestBody = "(upload omitted)"
+	} else if req.Body != nil {
+		body, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req = req.Clone(req.Context())
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		entry.RequestBody = redactBody(body)
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		entry.DurationMs = milliseconds(time.Since(start))
+		entry.Error = err.Error()
+		t.write(entry)
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	entry.DurationMs = milliseconds(time.Since(start))
+	entry.Status = resp.StatusCode
+	entry.ResponseHeaders = redactHeaders(resp.Header)
+	entry.ResponseBody = redactBody(body)
+	if err != nil {
+		entry.Error = err.Error()
+		t.write(entry)
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	t.write(entry)
+	return resp, nil
+}
+
+func (t *tracingTransport) write(entry traceEntry) {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	_, _ = t.w.Write(append(line, '\n'))
+}
+
+var redactedHeaders = []string{"Authorization", "Cookie", "Set-Cookie"}
+
+func redactHeaders(headers http.Header) http.Header {
+	redacted := headers.Clone()
+	for _, name := range redactedHeaders {
+		if redacted.Get(name) != "" {
+			redacted.Set(name, "REDACTED")
+		}
+	}
+	return redacted
+}
+
+// tokenFieldRegex matches the JSON fields in which the API sends tokens.
+var tokenFieldRegex = regexp.MustCompile(`("(?:jwt|token|authToken|auth_token)"\s*:\s*)"[^"]*"`)
+
+func redactBody(body []byte) string {
+	return tokenFieldRegex.ReplaceAllString(string(body), `$1"REDACTED"`)
+}
+
+func milliseconds(d time.Duration) float64 {
+	return float64(d.Microseconds()) / 1000
+}
@@ -0,0 +1,46 @@
+package turso
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestTraceRedactsTokens(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"jwt": "secret-database-token"}`))
+	}))
+	defer server.Close()
+
+	var trace bytes.Buffer
+	defer func(c *http.Client) { httpClient = c }(httpClient)
+	TraceTo(&trace)
+
+	base, _ := url.Parse(server.URL)
+	client := New(base, "secret-api-token", "dev", "")
+	token, err := client.Databases.Token("db", "never", false, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token != "secret-database-token" {
+		t.Fatalf("expected the response to reach the client, got %q", token)
+	}
+
+	if strings.Contains(trace.String(), "secret") {
+		t.Fatalf("expected tokens to be redacted, got %s", trace.String())
+	}
+	var entry traceEntry
+	if err := json.Unmarshal(trace.Bytes(), &entry); err != nil {
+		t.Fatal(err)
+	}
+	if entry.Method != "POST" || entry.Status != http.StatusOK || !strings.HasSuffix(entry.URL, "/v1/databases/db/auth/tokens?expiration=never") {
+		t.Fatalf("unexpected trace entry: %+v", entry)
+	}
+	if entry.RequestHeaders.Get("Authorization") != "REDACTED" {
+		t.Fatalf("expected the authorization header to be redacted, got %q", entry.RequestHeaders.Get("Authorization"))
+	}
+}
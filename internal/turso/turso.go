@@ -1,6 +1,7 @@
 package turso
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"mime/multipart"
@@ -9,17 +10,23 @@ import (
 	"net/url"
 	"os"
 	"runtime"
+	"sync"
 
 	"github.com/tursodatabase/turso-cli/internal/flags"
 )
 
 // Collection of all turso clients
 type Client struct {
+	ctx        context.Context
 	baseUrl    *url.URL
 	token      string
 	cliVersion string
 	Org        string
 
+	// Locations rarely change, so they are fetched once per client
+	locationsMu sync.Mutex
+	locations   map[string]string
+
 	// Single instance to be reused by all clients
 	base *client
 
@@ -63,6 +70,12 @@ func New(base *url.URL, token string, cliVersion string, org string) *Client {
 	return c
 }
 
+// SetContext makes the requests of the client use ctx, so that they are
+// aborted when it is cancelled.
+func (t *Client) SetContext(ctx context.Context) {
+	t.ctx = ctx
+}
+
 func (t *Client) newRequest(method, urlPath string, body io.Reader) (*http.Request, error) {
 	url, err := url.Parse(t.baseUrl.String())
 	if err != nil {
@@ -72,7 +85,11 @@ func (t *Client) newRequest(method, urlPath string, body io.Reader) (*http.Reque
 	if err != nil {
 		return nil, err
 	}
-	req, err := http.NewRequest(method, url.String(), body)
+	ctx := t.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	req, err := http.NewRequestWithContext(ctx, method, url.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -91,23 +108,38 @@ func (t *Client) newRequest(method, urlPath string, body io.Reader) (*http.Reque
 
 func (t *Client) do(method, path string, body io.Reader) (*http.Response, error) {
 	req, err := t.newRequest(method, path, body)
+	if err != nil {
+		return nil, err
+	}
+	return t.send(req)
+}
+
+func (t *Client) send(req *http.Request) (*http.Response, error) {
 	var reqDump string
 	if flags.Debug() {
 		reqDump = dumpRequest(req)
 	}
+	resp, err := httpClient.Do(req)
 	if err != nil {
-		return nil, err
-	}
-	resp, err := http.DefaultClient.Do(req)
-	if err != nil {
-		return nil, err
+		return nil, diagnoseNetworkError(req, err)
 	}
 	if flags.Debug() {
 		printDumps(reqDump, dumpResponse(resp))
 	}
+	if flags.Verbose() {
+		logRequest(req, resp)
+	}
 	return resp, nil
 }
 
+// requestIDHeader identifies a request in the server logs. Include it when
+// reporting issues to support.
+const requestIDHeader = "X-Request-Id"
+
+func logRequest(req *http.Request, resp *http.Response) {
+	fmt.Fprintf(os.Stderr, "%s %s: %s%s\n", req.Method, req.URL.Path, resp.Status, requestIDSuffix(resp))
+}
+
 func printDumps(req, resp string) {
 	if req != "" {
 		fmt.Println(req)
@@ -169,7 +201,7 @@ func (t *Client) Upload(path string, fileData *os.File) (*http.Response, error)
 		return nil, err
 	}
 	req.Header.Set("Content-Type", writer.FormDataContentType())
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := httpClient.Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -26,8 +26,20 @@ func marshal(data interface{}) (io.Reader, error) {
 
 func parseResponseError(res *http.Response) error {
 	type ErrorResponse struct{ Error interface{} }
-	if result, err := unmarshal[ErrorResponse](res); err == nil {
-		return fmt.Errorf("%s", result.Error)
+	if result, err := unmarshal[ErrorResponse](res); err == nil && result.Error != nil {
+		return newAPIError(res, fmt.Sprint(result.Error))
 	}
-	return fmt.Errorf("response failed with status %s", res.Status)
+	return newAPIError(res, fmt.Sprintf("response failed with status %s", res.Status))
+}
+
+func newAPIError(res *http.Response, message string) *APIError {
+	return &APIError{StatusCode: res.StatusCode, Message: message, RequestID: res.Header.Get(requestIDHeader)}
+}
+
+func requestIDSuffix(res *http.Response) string {
+	id := res.Header.Get(requestIDHeader)
+	if id == "" {
+		return ""
+	}
+	return fmt.Sprintf(" (request id: %s)", id)
 }